@@ -0,0 +1,288 @@
+/*
+crosstab computes a contingency table (a cross tabulation) of two input
+columns.
+
+  # Count requests by method and status code.
+  $ cat access.log | crosstab -r 6 -c 9
+        200 404 500 total
+  "GET  901  14   2   917
+  "POST  33   0   5    38
+  total 934  14   7   955
+
+  # Use the 10th field as weight, and render the table as a heatmap.
+  $ crosstab -r 6 -c 9 -w 10 -heat=shade < access.log
+
+  # You can set -ofs=, for CSV output, or \t for TSV.
+  $ crosstab -r 1 -c 2 -ofs=,
+
+Rows and columns are ordered by key. A total row and column are appended,
+labeled total, or [total] if a key is named total. Lines too short to have
+both keys are skipped with a warning.
+
+Currently only integer data is supported.
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gaal/shstat/internal"
+)
+
+var (
+	inDelim  = flag.String("ifs", `\s+`, "input field delimiter (regexp)")
+	outDelim = flag.String("ofs", ``, `output delimiter. {empty=auto formatting; \t=tab; other values taken literally}`)
+	quiet    = flag.Bool("q", false, "silence warnings on bad input")
+
+	rowspec    = flag.Int("r", 1, "row key column")
+	colspec    = flag.Int("c", 2, "column key column")
+	weightspec = flag.Int("w", 0, "weight column. Zero to use implicit weight 1 for all inputs. Negative values are allowed and count backwards from last column")
+
+	heat = flag.String("heat", "none", "render cells as a heatmap in auto formatting mode {none, shade, color}")
+)
+
+const totalKey = "total"
+
+type heatType int
+
+const (
+	heatNone heatType = iota
+	heatShade
+	heatColor
+)
+
+// shades are used to draw heatmaps, from lowest to highest value.
+var shades = []string{" ", "░", "▒", "▓", "█"}
+
+// table is a contingency table.
+type table struct {
+	rows, cols []string // keys, sorted.
+
+	cells  map[[2]string]int64
+	rowTot map[string]int64
+	colTot map[string]int64
+	total  int64
+	max    int64 // largest cell value, excluding totals.
+}
+
+func tabulate(in io.Reader, ifs *regexp.Regexp, rowCol, colCol, weightCol int) (*table, error) {
+	spec := []int{rowCol, colCol}
+	if weightCol != 0 {
+		spec = append(spec, weightCol)
+	}
+	p := internal.NewParter(ifs, spec)
+	t := &table{
+		cells:  make(map[[2]string]int64),
+		rowTot: make(map[string]int64),
+		colTot: make(map[string]int64),
+	}
+
+	s := bufio.NewScanner(in)
+	var nlines int
+	for s.Scan() {
+		nlines++
+		parts := p.Fields(s.Bytes())
+		if parts[0] == nil || parts[1] == nil {
+			if !*quiet {
+				fmt.Fprintf(os.Stderr, "bad input: line %d\n", nlines)
+			}
+			continue
+		}
+		w := int64(1)
+		if weightCol != 0 {
+			n, err := strconv.ParseInt(string(parts[2]), 10, 64)
+			if err != nil {
+				if !*quiet {
+					fmt.Fprintf(os.Stderr, "bad input: line %d\n", nlines)
+				}
+				continue
+			}
+			w = n
+		}
+		r, c := string(parts[0]), string(parts[1])
+		if _, ok := t.rowTot[r]; !ok {
+			t.rows = append(t.rows, r)
+		}
+		if _, ok := t.colTot[c]; !ok {
+			t.cols = append(t.cols, c)
+		}
+		t.cells[[2]string{r, c}] += w
+		t.rowTot[r] += w
+		t.colTot[c] += w
+		t.total += w
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	sort.Strings(t.rows)
+	sort.Strings(t.cols)
+	for _, v := range t.cells {
+		if v > t.max {
+			t.max = v
+		}
+	}
+	return t, nil
+}
+
+// totalLabel returns the label of the total row and column: totalKey, in
+// as many brackets as it takes to tell it from the keys.
+func (t *table) totalLabel() string {
+	label := totalKey
+	for {
+		if _, ok := t.rowTot[label]; !ok {
+			if _, ok := t.colTot[label]; !ok {
+				return label
+			}
+		}
+		label = "[" + label + "]"
+	}
+}
+
+// grid returns the table as rows of cells, including headers and totals.
+func (t *table) grid() [][]string {
+	itoa := func(v int64) string { return strconv.FormatInt(v, 10) }
+	totalLabel := t.totalLabel()
+
+	hdr := []string{""}
+	hdr = append(hdr, t.cols...)
+	hdr = append(hdr, totalLabel)
+	g := [][]string{hdr}
+	for _, r := range t.rows {
+		line := []string{r}
+		for _, c := range t.cols {
+			line = append(line, itoa(t.cells[[2]string{r, c}]))
+		}
+		line = append(line, itoa(t.rowTot[r]))
+		g = append(g, line)
+	}
+	line := []string{totalLabel}
+	for _, c := range t.cols {
+		line = append(line, itoa(t.colTot[c]))
+	}
+	line = append(line, itoa(t.total))
+	return append(g, line)
+}
+
+// heatCell decorates a padded cell according to v's intensity in the table.
+func (t *table) heatCell(cell string, v int64, ht heatType) string {
+	var lvl float64
+	if v > 0 && t.max > 0 {
+		lvl = float64(v) / float64(t.max)
+	}
+	switch ht {
+	case heatShade:
+		return cell + shades[int(lvl*float64(len(shades)-1)+0.5)]
+	case heatColor:
+		bg := 232 + int(lvl*23+0.5) // grayscale ramp in the 256-color palette.
+		fg := 37
+		if bg > 243 {
+			fg = 30
+		}
+		return fmt.Sprintf("\x1b[%d;48;5;%dm%s\x1b[0m", fg, bg, cell)
+	}
+	return cell
+}
+
+func (t *table) print(w io.Writer, ofs string, ht heatType) error {
+	g := t.grid()
+	out := bufio.NewWriter(w)
+	if ofs != "" {
+		for _, line := range g {
+			if _, err := fmt.Fprintln(out, strings.Join(line, ofs)); err != nil {
+				return err
+			}
+		}
+		return out.Flush()
+	}
+
+	widths := make([]int, len(g[0]))
+	for _, line := range g {
+		for i, cell := range line {
+			if n := internal.Width(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	for y, line := range g {
+		var cells []string
+		for x, cell := range line {
+			if x == 0 {
+				cells = append(cells, internal.Pad(cell, widths[x], false))
+				continue
+			}
+			cell = internal.Pad(cell, widths[x], true)
+			if ht != heatNone && y > 0 && y < len(g)-1 && x < len(line)-1 {
+				cell = t.heatCell(cell, t.cells[[2]string{t.rows[y-1], t.cols[x-1]}], ht)
+			} else if ht == heatShade {
+				cell += " "
+			}
+			cells = append(cells, cell)
+		}
+		if _, err := fmt.Fprintln(out, strings.TrimRight(strings.Join(cells, " "), " ")); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+func main() {
+	internal.SetUsage(
+		`crosstab computes a contingency table (a cross tabulation) of two input
+columns.
+
+  # Count requests by method and status code.
+  $ cat access.log | crosstab -r 6 -c 9
+        200 404 500 total
+  "GET  901  14   2   917
+  "POST  33   0   5    38
+  total 934  14   7   955
+
+  # Use the 10th field as weight, and render the table as a heatmap.
+  $ crosstab -r 6 -c 9 -w 10 -heat=shade < access.log
+
+  # You can set -ofs=, for CSV output, or \t for TSV.
+  $ crosstab -r 1 -c 2 -ofs=,
+
+Rows and columns are ordered by key. A total row and column are appended,
+labeled total, or [total] if a key is named total. Lines too short to have
+both keys are skipped with a warning.
+
+Currently only integer data is supported.
+`)
+	flag.Parse()
+
+	*outDelim = strings.Replace(*outDelim, `\t`, "\t", -1)
+	*inDelim = strings.Replace(*inDelim, `\t`, "\t", -1)
+	ifs := regexp.MustCompile(*inDelim)
+
+	var ht heatType
+	switch *heat {
+	case "none":
+		ht = heatNone
+	case "shade":
+		ht = heatShade
+	case "color":
+		ht = heatColor
+	default:
+		fmt.Fprintf(os.Stderr, "bad -heat=%q\n", *heat)
+		os.Exit(1)
+	}
+
+	t, err := tabulate(os.Stdin, ifs, *rowspec, *colspec, *weightspec)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := t.print(os.Stdout, *outDelim, ht); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
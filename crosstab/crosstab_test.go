@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestCrosstab(t *testing.T) {
+	const in = `GET 200 10
+GET 404 1
+POST 200 3
+GET 200 5
+PUT 500 x`
+	for _, d := range []struct {
+		weightCol int
+		ofs       string
+		ht        heatType
+		want      string
+	}{
+		{
+			want: strings.Join([]string{
+				"      200 404 500 total",
+				"GET     2   1   0     3",
+				"POST    1   0   0     1",
+				"PUT     0   0   1     1",
+				"total   3   1   1     5",
+				""}, "\n"),
+		},
+		{
+			weightCol: -1, ofs: ",",
+			want: strings.Join([]string{
+				",200,404,total",
+				"GET,15,1,16",
+				"POST,3,0,3",
+				"total,18,1,19",
+				""}, "\n"),
+		},
+		{
+			weightCol: 3, ht: heatShade,
+			want: strings.Join([]string{
+				"      200  404  total",
+				"GET    15█   1     16",
+				"POST    3░   0      3",
+				"total  18    1     19",
+				""}, "\n"),
+		},
+	} {
+		*quiet = true
+		tab, err := tabulate(bytes.NewBufferString(in), regexp.MustCompile(" +"), 1, 2, d.weightCol)
+		if err != nil {
+			t.Fatalf("tabulate returned unexpected error=%v", err)
+		}
+		have := &bytes.Buffer{}
+		if err := tab.print(have, d.ofs, d.ht); err != nil {
+			t.Fatalf("print returned unexpected error=%v", err)
+		}
+		if have.String() != d.want {
+			t.Errorf("crosstab (w=%d, ofs=%q) returned wrong results.\nhave=%q,\nwant=%q", d.weightCol, d.ofs, have.String(), d.want)
+		}
+	}
+}
+
+func TestCrosstabKeys(t *testing.T) {
+	// A key named total, a line too short to have a column key, and keys
+	// of wide characters.
+	const in = `total 東京
+short
+a 東京
+a b`
+	want := strings.Join([]string{
+		"        b 東京 [total]",
+		"a       1    1       2",
+		"total   0    1       1",
+		"[total] 1    2       3",
+		""}, "\n")
+	*quiet = true
+	tab, err := tabulate(bytes.NewBufferString(in), regexp.MustCompile(" +"), 1, 2, 0)
+	if err != nil {
+		t.Fatalf("tabulate returned unexpected error=%v", err)
+	}
+	have := &bytes.Buffer{}
+	if err := tab.print(have, "", heatNone); err != nil {
+		t.Fatalf("print returned unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("crosstab returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
@@ -0,0 +1,343 @@
+/*
+describe profiles tabular input, printing a summary of each column.
+
+  $ describe -header < requests.txt
+  field  type   count empty distinct min                 max                 mean    top
+  time   time    1204     0     1187 2017-03-14T09:00:02 2017-03-14T09:59:58         2017-03-14T09:15:26 (2), ...
+  method string  1204     0        3 GET                 POST                        GET (1101), POST (97), PUT (6)
+  status int     1204     0        4 200                 503                 224.51  200 (1120), 404 (61), 500 (19)
+  bytes  float   1204    17      955 0                   1500000             8211.3  0 (40), 512 (12), 1024 (9)
+
+  # The same, as JSON.
+  $ describe -header -json < requests.txt
+
+  # Profile the capture groups of a regexp, named or numbered.
+  $ describe -re 'user=(?P<user>\S+) ms=(?P<ms>\d+)' < app.log
+
+Columns are numbered from 1 unless named by -header or regexp groups. Types
+are inferred from all non-empty values: int, float, time (see hist -tfmt
+auto), or string. Distinct counts are exact up to a million distinct values
+per column, and estimated after that; top values are then only counted among
+the values seen first.
+*/
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gaal/shstat/internal"
+)
+
+var (
+	inDelim = flag.String("ifs", `\s+`, "input field delimiter (regexp)")
+	extract = flag.String("re", "", "take columns from the capture groups of this regexp instead of splitting by -ifs. Lines that do not match are skipped")
+	header  = flag.Bool("header", false, "input starts with a header line naming its fields")
+	ntop    = flag.Int("top", 3, "number of most common values to show per column")
+	asJSON  = flag.Bool("json", false, "print the profile as JSON")
+)
+
+// maxExact is the number of distinct values per column beyond which they are
+// no longer counted exactly.
+const maxExact = 1 << 20
+
+// column accumulates statistics on the values of a column.
+type column struct {
+	count int // non-empty values.
+
+	isInt, isFloat, isTime bool // whether all values so far are of the type.
+
+	sum              float64
+	minNum, maxNum   float64
+	minTime, maxTime time.Time
+	minStr, maxStr   string // as given, for each of the types above.
+	minTS, maxTS     string
+	minS, maxS       string
+
+	vals   map[string]int
+	approx *internal.HyperLogLog
+}
+
+func newColumn() *column {
+	hll, _ := internal.NewHyperLogLog(14)
+	return &column{
+		isInt:   true,
+		isFloat: true,
+		isTime:  true,
+		vals:    make(map[string]int),
+		approx:  hll,
+	}
+}
+
+var parseTime = internal.TimeParser("auto")
+
+func (c *column) add(v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	s := string(v)
+	c.count++
+	first := c.count == 1
+
+	if c.isInt {
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			c.isInt = false
+		}
+	}
+	if c.isFloat {
+		if n, err := strconv.ParseFloat(s, 64); err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			c.isFloat = false
+		} else {
+			c.sum += n
+			if first || n < c.minNum {
+				c.minNum, c.minStr = n, s
+			}
+			if first || n > c.maxNum {
+				c.maxNum, c.maxStr = n, s
+			}
+		}
+	}
+	// Times are tracked from the first value, as numbers may be Unix times.
+	if c.isTime {
+		if t, err := parseTime(s); err != nil {
+			c.isTime = false
+		} else {
+			if c.minTS == "" || t.Before(c.minTime) {
+				c.minTime, c.minTS = t, s
+			}
+			if c.maxTS == "" || t.After(c.maxTime) {
+				c.maxTime, c.maxTS = t, s
+			}
+		}
+	}
+	if first || s < c.minS {
+		c.minS = s
+	}
+	if first || s > c.maxS {
+		c.maxS = s
+	}
+
+	c.approx.Add(v)
+	if _, ok := c.vals[s]; ok || len(c.vals) < maxExact {
+		c.vals[s]++
+	}
+}
+
+type valueCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// profile is the summary of a column.
+type profile struct {
+	Field          string       `json:"field"`
+	Type           string       `json:"type"`
+	Count          int          `json:"count"`
+	Empty          int          `json:"empty"`
+	Distinct       uint64       `json:"distinct"`
+	DistinctApprox bool         `json:"distinct_approx,omitempty"`
+	Min            string       `json:"min,omitempty"`
+	Max            string       `json:"max,omitempty"`
+	Mean           *float64     `json:"mean,omitempty"`
+	Top            []valueCount `json:"top"`
+}
+
+func (c *column) profile(name string, nlines, ntop int) profile {
+	p := profile{
+		Field:    name,
+		Type:     "string",
+		Count:    c.count,
+		Empty:    nlines - c.count,
+		Distinct: uint64(len(c.vals)),
+		Min:      c.minS,
+		Max:      c.maxS,
+		Top:      []valueCount{},
+	}
+	switch {
+	case c.count == 0:
+		p.Type = "empty"
+	case c.isInt, c.isFloat:
+		p.Type = "float"
+		if c.isInt {
+			p.Type = "int"
+		}
+		p.Min, p.Max = c.minStr, c.maxStr
+		mean := c.sum / float64(c.count)
+		p.Mean = &mean
+	case c.isTime:
+		p.Type = "time"
+		p.Min, p.Max = c.minTS, c.maxTS
+	}
+	if len(c.vals) >= maxExact {
+		p.Distinct = c.approx.Count()
+		p.DistinctApprox = true
+	}
+	for v, n := range c.vals {
+		p.Top = append(p.Top, valueCount{v, n})
+	}
+	sort.Sort(byCountValue(p.Top))
+	if len(p.Top) > ntop {
+		p.Top = p.Top[:ntop]
+	}
+	return p
+}
+
+type byCountValue []valueCount
+
+func (a byCountValue) Len() int      { return len(a) }
+func (a byCountValue) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byCountValue) Less(i, j int) bool {
+	if a[i].Count != a[j].Count {
+		return a[i].Count > a[j].Count
+	}
+	return a[i].Value < a[j].Value
+}
+
+// describe profiles the columns p parts input lines into. p should return
+// all fields, as Parter.All does.
+func describe(in io.Reader, p *internal.Parter, header bool, ntop int) ([]profile, error) {
+	names := p.Names()
+	var cols []*column
+	var nlines int
+	s := bufio.NewScanner(in)
+	for first := true; s.Scan(); first = false {
+		line := s.Bytes()
+		if first && header {
+			names = internal.HeaderNames(p, line)
+			continue
+		}
+		fields := p.Fields(line)
+		if fields == nil {
+			continue
+		}
+		nlines++
+		for len(cols) < len(fields) {
+			cols = append(cols, newColumn())
+		}
+		for i, v := range fields {
+			cols[i].add(v)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	var ps []profile
+	for i, c := range cols {
+		name := strconv.Itoa(i + 1)
+		if i < len(names) && names[i] != "" {
+			name = names[i]
+		}
+		ps = append(ps, c.profile(name, nlines, ntop))
+	}
+	return ps, nil
+}
+
+// row returns the cells of a profile's line in the human-readable table.
+func (p profile) row() []string {
+	distinct := strconv.FormatUint(p.Distinct, 10)
+	if p.DistinctApprox {
+		distinct = "~" + distinct
+	}
+	var mean string
+	if p.Mean != nil {
+		mean = internal.FormatNumber(*p.Mean)
+	}
+	var top []string
+	for _, v := range p.Top {
+		top = append(top, fmt.Sprintf("%s (%d)", v.Value, v.Count))
+	}
+	return []string{p.Field, p.Type, strconv.Itoa(p.Count), strconv.Itoa(p.Empty), distinct, p.Min, p.Max, mean, strings.Join(top, ", ")}
+}
+
+var tableHeader = []string{"field", "type", "count", "empty", "distinct", "min", "max", "mean", "top"}
+
+// rightAligned reports which columns of the table are numbers.
+var rightAligned = []bool{false, false, true, true, true, false, false, false, false}
+
+func printTable(w io.Writer, ps []profile) error {
+	rows := [][]string{tableHeader}
+	for _, p := range ps {
+		rows = append(rows, p.row())
+	}
+	widths := make([]int, len(tableHeader))
+	for _, r := range rows {
+		for i, v := range r {
+			if n := internal.Width(v); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	for _, r := range rows {
+		var cells []string
+		for i, v := range r {
+			if rightAligned[i] || i < len(r)-1 {
+				v = internal.Pad(v, widths[i], rightAligned[i])
+			}
+			cells = append(cells, v)
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimRight(strings.Join(cells, " "), " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	internal.SetUsage(
+		`describe profiles tabular input, printing a summary of each column.
+
+  $ describe -header < requests.txt
+  field  type   count empty distinct min                 max                 mean    top
+  time   time    1204     0     1187 2017-03-14T09:00:02 2017-03-14T09:59:58         2017-03-14T09:15:26 (2), ...
+  method string  1204     0        3 GET                 POST                        GET (1101), POST (97), PUT (6)
+  status int     1204     0        4 200                 503                 224.51  200 (1120), 404 (61), 500 (19)
+  bytes  float   1204    17      955 0                   1500000             8211.3  0 (40), 512 (12), 1024 (9)
+
+  # The same, as JSON.
+  $ describe -header -json < requests.txt
+
+  # Profile the capture groups of a regexp, named or numbered.
+  $ describe -re 'user=(?P<user>\S+) ms=(?P<ms>\d+)' < app.log
+
+Columns are numbered from 1 unless named by -header or regexp groups. Types
+are inferred from all non-empty values: int, float, time (see hist -tfmt
+auto), or string. Distinct counts are exact up to a million distinct values
+per column, and estimated after that; top values are then only counted among
+the values seen first.
+`)
+	flag.Parse()
+
+	var p *internal.Parter
+	if *extract != "" {
+		p = internal.NewMatchParter(regexp.MustCompile(*extract), nil)
+	} else {
+		*inDelim = strings.Replace(*inDelim, `\t`, "\t", -1)
+		p = internal.NewParter(regexp.MustCompile(*inDelim), nil)
+	}
+	ps, err := describe(os.Stdin, p, *header, *ntop)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(ps)
+	} else {
+		err = printTable(os.Stdout, ps)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gaal/shstat/internal"
+)
+
+func TestDescribe(t *testing.T) {
+	const in = `time method status bytes
+2017-03-14T09:15:26Z GET 200 512
+2017-03-14T09:15:59Z GET 404 0.5
+2017-03-14T09:14:01Z POST 200 1024
+2017-03-14T09:16:30Z GET 200`
+	ps, err := describe(bytes.NewBufferString(in), internal.NewParter(regexp.MustCompile(" +"), nil), true, 2)
+	if err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	have := &bytes.Buffer{}
+	if err := printTable(have, ps); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	want := strings.Join([]string{
+		"field  type   count empty distinct min                  max                  mean    top",
+		"time   time       4     0        4 2017-03-14T09:14:01Z 2017-03-14T09:16:30Z         2017-03-14T09:14:01Z (1), 2017-03-14T09:15:26Z (1)",
+		"method string     4     0        2 GET                  POST                         GET (3), POST (1)",
+		"status int        4     0        2 200                  404                  251     200 (3), 404 (1)",
+		"bytes  float      3     1        3 0.5                  1024                 512.167 0.5 (1), 1024 (1)",
+		""}, "\n")
+	if have.String() != want {
+		t.Errorf("describe returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestDescribeMatch(t *testing.T) {
+	const in = `user=ann ms=12
+noise
+user=bob ms=7
+user=ann ms=`
+	re := regexp.MustCompile(`user=(?P<user>\S+) ms=(\d*)`)
+	ps, err := describe(bytes.NewBufferString(in), internal.NewMatchParter(re, nil), false, 1)
+	if err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if len(ps) != 2 {
+		t.Fatalf("describe returned %d columns, want 2: %+v", len(ps), ps)
+	}
+	for i, d := range []struct {
+		field, typ   string
+		count, empty int
+		min, max     string
+	}{
+		{"user", "string", 3, 0, "ann", "bob"},
+		{"2", "int", 2, 1, "7", "12"},
+	} {
+		p := ps[i]
+		if p.Field != d.field || p.Type != d.typ || p.Count != d.count || p.Empty != d.empty || p.Min != d.min || p.Max != d.max {
+			t.Errorf("describe column %d=%+v, want field=%s type=%s count=%d empty=%d min=%s max=%s", i+1, p, d.field, d.typ, d.count, d.empty, d.min, d.max)
+		}
+	}
+}
+
+func TestDescribeTypes(t *testing.T) {
+	// Unix times before RFC 3339 ones, non-finite numbers, and wide text.
+	const in = `5 1.5 東京
+2017-03-14T09:15:26Z NaN a
+1489400000 2 b`
+	ps, err := describe(bytes.NewBufferString(in), internal.NewParter(regexp.MustCompile(" +"), nil), false, 1)
+	if err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	for i, d := range []struct {
+		typ      string
+		min, max string
+	}{
+		{"time", "5", "2017-03-14T09:15:26Z"},
+		{"string", "1.5", "NaN"},
+	} {
+		p := ps[i]
+		if p.Type != d.typ || p.Min != d.min || p.Max != d.max || p.Mean != nil {
+			t.Errorf("describe column %d=%+v, want type=%s min=%s max=%s and no mean", i+1, p, d.typ, d.min, d.max)
+		}
+	}
+	have := &bytes.Buffer{}
+	if err := printTable(have, ps[2:]); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	want := strings.Join([]string{
+		"field type   count empty distinct min max  mean top",
+		"3     string     3     0        3 a   東京      a (1)",
+		""}, "\n")
+	if have.String() != want {
+		t.Errorf("describe returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
@@ -12,6 +12,40 @@ fld selects fields to print from tabular input.
   # Same thing with alternate syntax.
   fld -k 1,2,-1
 
+  # Prints the domain of email addresses found in input. With -re, fields are
+  # the capture groups of a regexp, numbered or named. Lines that do not match
+  # are skipped.
+  fld -re '(\w+)@(\S+)' 2
+  fld -re '(?P<user>\w+)@(?P<domain>\S+)' domain
+
+  # Prints lines whose 3rd field is over 1000, and whose first starts with GET.
+  # With -header, the first line names fields and is printed unfiltered.
+  fld -where '$3 > 1000 and $1 ~ /^GET/'
+  fld -header -where '$status == 500' method path
+
+  # Prints the first field, and the 5th divided by a million. Arguments that
+  # are not field indices or names, and have a $ or an operator, are
+  # expressions, as in -where. See below.
+  fld 1 '$5/1e6'
+  fld 1 'sprintf("%.2f", $5/1e6)' 'human($5)' 'lower($3)'
+
+  # Prints ragged input as an aligned table. Numbers are right-aligned, and
+  # widths count terminal cells, so wide characters line up too. -maxw
+  # snippets long cells; -border draws a box; -window N prints every N lines
+  # instead of reading all input first.
+  fld -table -header name size path < files.txt
+  fld -table -border -maxw 30 < data.txt
+
+Expressions support arithmetic, comparisons and the following functions:
+
+  length(s)          length of s in characters
+  lower(s), upper(s) s in lower or upper case
+  substr(s, i[, n])  n characters of s, starting from the i-th (1-based)
+  int(x)             x truncated to an integer
+  round(x[, d])      x rounded to d decimal places
+  human(x)           x as a human-readable byte size, such as 1.5K or 12M
+  sprintf(f, ...)    its arguments, formatted per f as in Go's fmt
+
 Credit to Mark-Jason Dominus for the idea.
 */
 package main
@@ -24,6 +58,7 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/gaal/shstat/internal"
@@ -33,30 +68,171 @@ var (
 	inDelim  = flag.String("ifs", `\s+`, "input field delimiter (regexp)")
 	outDelim = flag.String("ofs", " ", "output field separator (string)")
 	keyspec  = flag.String("k", "", "field indices")
+	extract  = flag.String("re", "", "take fields from the capture groups of this regexp instead of splitting by -ifs")
+	where    = flag.String("where", "", "only process lines matching this filter expression")
+	header   = flag.Bool("header", false, "input starts with a header line naming its fields")
+	tbl      = flag.Bool("table", false, "align output in columns, right-aligning numbers. Widths are in terminal cells")
+	border   = flag.Bool("border", false, "with -table, draw a box around cells")
+	maxw     = flag.Int("maxw", 0, "with -table, snippet cells wider than this. Zero for no limit")
+	window   = flag.Int("window", 0, "with -table, print every this many lines, for streaming; columns may widen between windows. Zero to read all input first")
 )
 
-func fld(in io.Reader, w io.Writer, ifs *regexp.Regexp, ofs string, idx []int) error {
-	p := internal.NewParter(ifs, idx)
+// fld prints the fields p selects from lines in in that match f. If cols is
+// set, it prints their values instead, computed over the fields p selects.
+func fld(in io.Reader, w io.Writer, p *internal.Parter, f *internal.Filter, cols []*internal.Expr, ofs string) error {
 	ofsb := []byte(ofs)
 
 	out := bufio.NewWriter(w)
-	s := bufio.NewScanner(in)
-	for s.Scan() {
-		parts := p.Fields(s.Bytes())
+	err := scan(in, p, f, cols, func(parts [][]byte, _ bool) error {
 		if _, err := out.Write(bytes.Join(parts, ofsb)); err != nil {
 			return err
 		}
-		if _, err := out.Write([]byte("\n")); err != nil {
+		_, err := out.Write([]byte("\n"))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+// scan calls emit with the fields fld prints for each line, and whether the
+// line is the header.
+func scan(in io.Reader, p *internal.Parter, f *internal.Filter, cols []*internal.Expr, emit func(parts [][]byte, hdr bool) error) error {
+	s := bufio.NewScanner(in)
+	for first := true; s.Scan(); first = false {
+		line := s.Bytes()
+		hdr := first && *header
+		if hdr {
+			if err := f.SetHeader(line); err != nil {
+				return err
+			}
+			for _, c := range cols {
+				if err := c.SetNames(internal.HeaderNames(p, line)); err != nil {
+					return err
+				}
+			}
+		} else if !f.Match(line) {
+			continue
+		}
+		parts := p.Fields(line)
+		if parts == nil { // no match
+			continue
+		}
+		if cols != nil {
+			vals := make([][]byte, len(cols))
+			for i, c := range cols {
+				if _, ok := c.Field(); hdr && !ok {
+					vals[i] = []byte(c.String())
+				} else {
+					vals[i] = []byte(c.Eval(parts))
+				}
+			}
+			parts = vals
+		}
+		if err := emit(parts, hdr); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
+
+// run prints the fields or expressions keys select from in, as the flags
+// ask. Keys may be field indices, or names of fields: of capture groups with
+// -re, or in the header with -header.
+func run(keys []string, in io.Reader, w io.Writer) error {
+	*outDelim = strings.Replace(*outDelim, `\t`, "\t", -1) // silent magic for now, figure it out later.
+	re := regexp.MustCompile(*inDelim)
+	all := internal.NewParter(re, nil)
+	if *extract != "" {
+		re = regexp.MustCompile(*extract)
+		all = internal.NewMatchParter(re, nil)
+	}
+	names := all.Names()
+	if *header {
+		// Read the header ahead, to resolve names in keys, and put it back.
+		br := bufio.NewReader(in)
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
 			return err
 		}
+		names = internal.HeaderNames(all, bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r")))
+		in = io.MultiReader(bytes.NewReader(line), br)
 	}
-	if err := s.Err(); err != nil {
+	idx, cols, err := parseKeys(keys, names)
+	if err != nil {
 		return err
 	}
-	if err := out.Flush(); err != nil {
+	p := internal.NewParter(re, idx)
+	if *extract != "" {
+		p = internal.NewMatchParter(re, idx)
+	}
+	if !*header {
+		for _, c := range cols {
+			if err := c.SetNames(p.Names()); err != nil {
+				return err
+			}
+		}
+	}
+	f, err := internal.ParseFilter(*where, p, *header)
+	if err != nil {
 		return err
 	}
-	return nil
+	if *tbl {
+		t := newTable(w, *maxw, *border, *window)
+		if err := scan(in, p, f, cols, t.add); err != nil {
+			return err
+		}
+		return t.close()
+	}
+	return fld(in, w, p, f, cols, *outDelim)
+}
+
+// exprChars are the characters that mark a key as an expression: a field
+// reference, an operator, or the parentheses of a call.
+const exprChars = "$+-*/%<>=!~&|()"
+
+// parseKeys returns the field indices keys select. Keys may be indices, or
+// names of fields in names. If some keys are expressions, it returns them
+// all as expressions instead, over all fields. Only keys with a $ or an
+// operator are taken for expressions, so that a misspelled name is an error
+// rather than a string literal.
+func parseKeys(keys []string, names []string) ([]int, []*internal.Expr, error) {
+	var idx []int
+	var exprs []string
+	for _, k := range keys {
+		i, err := strconv.Atoi(k)
+		if err != nil {
+			i = 0
+			for j, n := range names {
+				if n == k && n != "" {
+					i = j + 1
+					break
+				}
+			}
+		}
+		if i == 0 && err != nil {
+			if !strings.ContainsAny(k, exprChars) {
+				return nil, nil, fmt.Errorf("not a field index, name or expression: %q", k)
+			}
+			exprs = append(exprs, k)
+			continue
+		}
+		idx = append(idx, i)
+		exprs = append(exprs, "$"+strconv.Itoa(i))
+	}
+	if len(idx) == len(keys) {
+		return idx, nil, nil
+	}
+	var cols []*internal.Expr
+	for _, s := range exprs {
+		e, err := internal.NewExpr(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		cols = append(cols, e)
+	}
+	return nil, cols, nil
 }
 
 func main() {
@@ -75,15 +251,7 @@ func main() {
 		}
 		keys = strings.Split(*keyspec, ",")
 	}
-
-	ifs := regexp.MustCompile(*inDelim)
-	*outDelim = strings.Replace(*outDelim, `\t`, "\t", -1) // silent magic for now, figure it out later.
-	idx, err := internal.AtoiList(keys)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
-	}
-	if err := fld(os.Stdin, os.Stdout, ifs, *outDelim, idx); err != nil {
+	if err := run(keys, os.Stdin, os.Stdout); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"regexp"
 	"testing"
+
+	"github.com/gaal/shstat/internal"
 )
 
 func TestFld(t *testing.T) {
@@ -25,10 +27,200 @@ one  two
 	re := regexp.MustCompile("_+")
 	ofs := " "
 	have := &bytes.Buffer{}
-	if err := fld(bytes.NewBufferString(in), have, re, ofs, []int{1, 5, -2}); err != nil {
+	if err := fld(bytes.NewBufferString(in), have, internal.NewParter(re, []int{1, 5, -2}), nil, nil, ofs); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("fld returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestFldMatch(t *testing.T) {
+	in :=
+		`From: joe@example.com
+To: moose@example.org, elk@example.net
+Subject: hi`
+	want :=
+		`example.com joe
+example.org moose
+`
+	re := regexp.MustCompile(`(\w+)@(\S+?),?(?:\s|$)`)
+	have := &bytes.Buffer{}
+	if err := fld(bytes.NewBufferString(in), have, internal.NewMatchParter(re, []int{2, 1}), nil, nil, " "); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("fld returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestFldWhere(t *testing.T) {
+	in :=
+		`method path status
+GET / 200
+POST /login 500
+GET /x 500`
+	want :=
+		`path method
+/login POST
+`
+	p := internal.NewParter(regexp.MustCompile(" +"), []int{2, 1})
+	f, err := internal.ParseFilter(`$status == 500 && $method != GET`, p, true)
+	if err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	*header = true
+	defer func() { *header = false }()
+	have := &bytes.Buffer{}
+	if err := fld(bytes.NewBufferString(in), have, p, f, nil, " "); err != nil {
 		t.Fatalf("unexpected error=%v", err)
 	}
 	if have.String() != want {
 		t.Errorf("fld returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
 	}
 }
+
+func TestFldExpr(t *testing.T) {
+	in :=
+		`name size
+Foo.TXT 1536
+bar 100`
+	want :=
+		`name $size/1024 human($size) lower(substr($name,1,3))
+Foo.TXT 1.5 1.5K foo
+bar 0.0976562 100 bar
+`
+	var cols []*internal.Expr
+	for _, s := range []string{"$1", "$size/1024", "human($size)", "lower(substr($name,1,3))"} {
+		e, err := internal.NewExpr(s)
+		if err != nil {
+			t.Fatalf("NewExpr(%q) returned unexpected error=%v", s, err)
+		}
+		cols = append(cols, e)
+	}
+	*header = true
+	defer func() { *header = false }()
+	have := &bytes.Buffer{}
+	if err := fld(bytes.NewBufferString(in), have, internal.NewParter(regexp.MustCompile(" +"), nil), nil, cols, " "); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("fld returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestFldTable(t *testing.T) {
+	in := `name size path
+日本語 1.5K /a
+b 100 /a/much/longer/path
+cc 7`
+	p := internal.NewParter(regexp.MustCompile(" +"), nil)
+	for _, d := range []struct {
+		maxw   int
+		border bool
+		window int
+		want   string
+	}{
+		{
+			want: `name   size path
+日本語 1.5K /a
+b       100 /a/much/longer/path
+cc        7
+`,
+		},
+		{
+			maxw:   8,
+			border: true,
+			want: `┌────────┬──────┬──────────┐
+│ name   │ size │ path     │
+├────────┼──────┼──────────┤
+│ 日本語 │ 1.5K │ /a       │
+│ b      │  100 │ /a/much… │
+│ cc     │    7 │          │
+└────────┴──────┴──────────┘
+`,
+		},
+		{
+			window: 2,
+			want: `name   size path
+日本語 1.5K /a
+b       100 /a/much/longer/path
+cc        7
+`,
+		},
+	} {
+		*header = true
+		have := &bytes.Buffer{}
+		tb := newTable(have, d.maxw, d.border, d.window)
+		err := scan(bytes.NewBufferString(in), p, nil, nil, tb.add)
+		if err == nil {
+			err = tb.close()
+		}
+		*header = false
+		if err != nil {
+			t.Fatalf("unexpected error=%v", err)
+		}
+		if have.String() != d.want {
+			t.Errorf("fld -table (maxw=%d border=%v window=%d) returned wrong results.\nhave=%q,\nwant=%q", d.maxw, d.border, d.window, have.String(), d.want)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	in := `method path status
+GET / 200
+POST /login 500
+GET /x 500`
+	for _, d := range []struct {
+		keys   []string
+		header bool
+		where  string
+		table  bool
+		want   string
+	}{
+		{
+			keys: []string{"3", "1"}, where: "$3 == 500",
+			want: "500 POST\n500 GET\n",
+		},
+		{
+			keys: []string{"method", "path"}, header: true, where: "$status == 500",
+			want: "method path\nPOST /login\nGET /x\n",
+		},
+		{
+			keys: []string{"path", "$status/100"}, header: true,
+			want: "path $status/100\n/ 2\n/login 5\n/x 5\n",
+		},
+		{
+			keys: []string{"path", "status"}, header: true, table: true,
+			want: "path   status\n/         200\n/login    500\n/x        500\n",
+		},
+	} {
+		*header, *where, *tbl = d.header, d.where, d.table
+		have := &bytes.Buffer{}
+		err := run(d.keys, bytes.NewBufferString(in), have)
+		*header, *where, *tbl = false, "", false
+		if err != nil {
+			t.Fatalf("run(%q) returned unexpected error=%v", d.keys, err)
+		}
+		if have.String() != d.want {
+			t.Errorf("run(%q) (header=%v where=%q table=%v) returned wrong results.\nhave=%q,\nwant=%q", d.keys, d.header, d.where, d.table, have.String(), d.want)
+		}
+	}
+}
+
+func TestRunBadKey(t *testing.T) {
+	for _, d := range []struct {
+		keys   []string
+		header bool
+	}{
+		{keys: []string{"1", "foo"}},
+		{keys: []string{"method", "pth"}, header: true},
+	} {
+		*header = d.header
+		err := run(d.keys, bytes.NewBufferString("method path\nGET /\n"), &bytes.Buffer{})
+		*header = false
+		if err == nil {
+			t.Errorf("run(%q) (header=%v) succeeded, want error", d.keys, d.header)
+		}
+	}
+}
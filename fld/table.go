@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/gaal/shstat/internal"
+)
+
+// table prints rows aligned in columns. Columns of numbers are right-aligned.
+type table struct {
+	w      *bufio.Writer
+	maxw   int  // maximum cell width, in cells. Zero for no limit.
+	border bool // draw a box around cells.
+	window int  // rows to buffer before printing. Zero to buffer all input.
+
+	rows   [][]string
+	hdr    int    // index of the header row in rows, or -1.
+	widths []int  // column widths so far, in cells.
+	text   []bool // whether a column has cells that are not numbers.
+	begun  bool   // whether any rows were printed.
+}
+
+func newTable(w io.Writer, maxw int, border bool, window int) *table {
+	return &table{w: bufio.NewWriter(w), maxw: maxw, border: border, window: window, hdr: -1}
+}
+
+// add adds a row to the table, printing buffered rows if the window is full.
+func (t *table) add(parts [][]byte, hdr bool) error {
+	row := make([]string, len(parts))
+	for i, v := range parts {
+		row[i] = string(v)
+		if t.maxw > 0 {
+			row[i], _ = internal.Snip(row[i], t.maxw)
+		}
+	}
+	for len(t.widths) < len(row) {
+		t.widths = append(t.widths, 0)
+		t.text = append(t.text, false)
+	}
+	for i, v := range row {
+		if w := internal.Width(v); w > t.widths[i] {
+			t.widths[i] = w
+		}
+		if !hdr && v != "" && !t.text[i] {
+			if _, err := internal.ParseNumber(v); err != nil {
+				t.text[i] = true
+			}
+		}
+	}
+	if hdr {
+		t.hdr = len(t.rows)
+	}
+	t.rows = append(t.rows, row)
+	if t.window > 0 && len(t.rows) >= t.window {
+		return t.flush()
+	}
+	return nil
+}
+
+// rule returns a horizontal border line, with the given box drawing
+// characters at the left, at column boundaries and at the right.
+func (t *table) rule(l, m, r string) string {
+	var segs []string
+	for _, w := range t.widths {
+		segs = append(segs, strings.Repeat("─", w+2))
+	}
+	return l + strings.Join(segs, m) + r
+}
+
+func (t *table) line(row []string) string {
+	cells := make([]string, len(t.widths))
+	for i, w := range t.widths {
+		var v string
+		if i < len(row) {
+			v = row[i]
+		}
+		if t.border || i < len(t.widths)-1 || !t.text[i] {
+			v = internal.Pad(v, w, !t.text[i])
+		}
+		cells[i] = v
+	}
+	if t.border {
+		return "│ " + strings.Join(cells, " │ ") + " │"
+	}
+	return strings.TrimRight(strings.Join(cells, " "), " ")
+}
+
+// flush prints the buffered rows. Columns may widen in later flushes.
+func (t *table) flush() error {
+	var lines []string
+	if t.border && !t.begun && len(t.rows) > 0 {
+		lines = append(lines, t.rule("┌", "┬", "┐"))
+	}
+	for i, row := range t.rows {
+		lines = append(lines, t.line(row))
+		if i == t.hdr && t.border {
+			lines = append(lines, t.rule("├", "┼", "┤"))
+		}
+	}
+	for _, l := range lines {
+		if _, err := t.w.WriteString(l + "\n"); err != nil {
+			return err
+		}
+	}
+	t.begun = t.begun || len(t.rows) > 0
+	t.rows = t.rows[:0]
+	t.hdr = -1
+	return t.w.Flush()
+}
+
+// close prints any buffered rows and finishes the table.
+func (t *table) close() error {
+	if err := t.flush(); err != nil {
+		return err
+	}
+	if t.border && t.begun {
+		if _, err := t.w.WriteString(t.rule("└", "┴", "┘") + "\n"); err != nil {
+			return err
+		}
+	}
+	return t.w.Flush()
+}
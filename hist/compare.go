@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gaal/shstat/internal"
+)
+
+// cmpAvail is the width of each count column in a comparative histogram.
+const cmpAvail = 10
+
+// cmpRow is a row in a comparative histogram: a key and its count in each of
+// the input series.
+type cmpRow struct {
+	key   string
+	parts []string // fields of a composite key, nil otherwise.
+	cnts  []float64
+}
+
+// total returns the sum of counts over all series.
+func (r cmpRow) total() float64 {
+	var t float64
+	for _, v := range r.cnts {
+		t += v
+	}
+	return t
+}
+
+// delta returns the difference between the last and the first series.
+func (r cmpRow) delta() float64 { return r.cnts[len(r.cnts)-1] - r.cnts[0] }
+
+// ratio returns the ratio of the last series to the first, formatted. There
+// is no ratio to 0, which is shown as -.
+func (r cmpRow) ratio() string {
+	if r.cnts[0] == 0 {
+		return "-"
+	}
+	return strconv.FormatFloat(r.cnts[len(r.cnts)-1]/r.cnts[0], 'f', 2, 64)
+}
+
+// byTotalKey sorts cmpRows lexically by total counts, then keys.
+type byTotalKey []cmpRow
+
+func (a byTotalKey) Len() int      { return len(a) }
+func (a byTotalKey) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byTotalKey) Less(i, j int) bool {
+	ti, tj := a[i].total(), a[j].total()
+	if ti < tj {
+		return true
+	}
+	if ti > tj {
+		return false
+	}
+	return a[i].key < a[j].key
+}
+
+// cmplinefmt is like hlinefmt, for comparative histograms with ncols count
+// columns. All fields in the format are strings.
+func cmplinefmt(tw, ncols int, graph bool, ofs string) (hfmt string, kavail int, gavail int) {
+	sep := strings.Replace(ofs, "%", "%%", -1)
+	if ofs == "" { // autoformatting
+		sep = " "
+	}
+	rest := tw - ncols*(cmpAvail+1)
+	if graph {
+		kavail = rest / 2
+		gavail = rest - kavail - 1
+	} else {
+		kavail = rest
+	}
+	if kavail < 1 {
+		kavail = 1
+	}
+	if gavail < 0 {
+		gavail = 0
+	}
+	var fs []string
+	for i := 0; i <= ncols; i++ {
+		fs = append(fs, "%s")
+	}
+	if graph {
+		fs = append(fs, "%s")
+	}
+	return strings.Join(fs, sep), kavail, gavail
+}
+
+// ncols returns the number of count columns in comparative output.
+func (h histogrammer) ncols() int {
+	n := len(h.series)
+	if h.delta {
+		n++
+	}
+	if h.ratio {
+		n++
+	}
+	return n
+}
+
+// compare computes a comparative histogram, treating each element of ins as
+// a series named by the corresponding element of names. If h.byCol is set,
+// series are instead named by the value of that column, and names is ignored.
+func (h *histogrammer) compare(names []string, ins []io.Reader) ([]cmpRow, error) {
+	h.series = nil
+	col := make(map[string]int)
+	addSeries := func(name string) int {
+		i, ok := col[name]
+		if !ok {
+			i = len(h.series)
+			col[name] = i
+			h.series = append(h.series, name)
+		}
+		return i
+	}
+	var bp *internal.Parter
+	if h.byCol != 0 {
+		bp = h.parter([]int{h.byCol})
+	} else {
+		for _, name := range names {
+			addSeries(name)
+		}
+	}
+
+	d := make(map[string][]float64)
+	for i, in := range ins {
+		err := h.scan(in, func(k string, w float64, line []byte) {
+			si := i
+			if bp != nil {
+				si = addSeries(string(bp.Fields(line)[0]))
+			}
+			c := d[k]
+			for len(c) <= si {
+				c = append(c, 0)
+			}
+			c[si] += w
+			d[k] = c
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(h.series) == 0 {
+		return nil, nil
+	}
+
+	var rows []cmpRow
+	var parts [][]string
+	if h.tparse != nil {
+		keys, err := h.timeKeys()
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			v := d[k]
+			for len(v) < len(h.series) {
+				v = append(v, 0)
+			}
+			rows = append(rows, cmpRow{key: k, cnts: v})
+		}
+	} else {
+		for k, v := range d {
+			for len(v) < len(h.series) {
+				v = append(v, 0)
+			}
+			key, p := h.splitKey(k)
+			rows = append(rows, cmpRow{key: key, parts: p, cnts: v})
+			parts = append(parts, p)
+		}
+		sort.Sort(byTotalKey(rows))
+	}
+	h.layoutKeys(parts)
+
+	h.hfmt, h.kavail, h.gavail = cmplinefmt(h.termWidth, h.ncols(), h.gt != gNone, h.ofs)
+	h.fitKeys()
+	var deltas []float64
+	for _, r := range rows {
+		deltas = append(deltas, r.delta())
+	}
+	h.setScale(deltas)
+
+	return rows, nil
+}
+
+// cmpHeader returns the header line of a comparative histogram. With an
+// output delimiter, each field of a composite key has a column, named key1,
+// key2 and so on, and the graph column, if any, has an empty name.
+func (h histogrammer) cmpHeader() string {
+	var fs []string
+	for _, s := range h.series {
+		if h.ofs == "" {
+			s, _ = internal.Snip(s, cmpAvail)
+		}
+		fs = append(fs, h.pad(s, cmpAvail, true))
+	}
+	if h.delta {
+		fs = append(fs, h.pad("delta", cmpAvail, true))
+	}
+	if h.ratio {
+		fs = append(fs, h.pad("ratio", cmpAvail, true))
+	}
+	if h.ofs != "" {
+		if len(h.keys) < 2 {
+			fs = append(fs, "key")
+		} else {
+			for i := range h.keys {
+				fs = append(fs, fmt.Sprintf("key%d", i+1))
+			}
+		}
+		if h.gt != gNone {
+			fs = append(fs, "")
+		}
+		return strings.Join(fs, h.ofs)
+	}
+	fs = append(fs, h.pad("key", h.kavail, false))
+	if h.gt != gNone {
+		fs = append(fs, "")
+	}
+	args := make([]interface{}, len(fs))
+	for i, f := range fs {
+		args[i] = f
+	}
+	return strings.TrimRight(fmt.Sprintf(h.hfmt, args...), " ")
+}
+
+// cmpline formats a row of a comparative histogram. The graph shows the
+// difference between the last and first series, as a bar of + or -.
+func (h histogrammer) cmpline(r cmpRow) string {
+	var fs []interface{}
+	for _, v := range r.cnts {
+		fs = append(fs, h.pad(h.fmtCount(v), cmpAvail, true))
+	}
+	if h.delta {
+		sign := "+"
+		if r.delta() < 0 {
+			sign = "-"
+		}
+		fs = append(fs, h.pad(sign+h.fmtCount(math.Abs(r.delta())), cmpAvail, true))
+	}
+	if h.ratio {
+		fs = append(fs, h.pad(r.ratio(), cmpAvail, true))
+	}
+	key := h.dispKey(r.key, r.parts)
+	if h.snip {
+		key = h.snipKey(key)
+	}
+	fs = append(fs, h.pad(key, h.kavail, false))
+	if h.gt != gNone {
+		fs = append(fs, h.bar(r.delta()))
+	}
+	return strings.TrimRight(fmt.Sprintf(h.hfmt, fs...), " ")
+}
+
+func (h histogrammer) printCompare(out io.Writer, rows []cmpRow) error {
+	if len(h.series) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(out, h.cmpHeader()); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if _, err := fmt.Fprintln(out, h.cmpline(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	const (
+		in1 = `a 404
+b 200
+c 500`
+		in2 = `a 404
+b 200
+c 200
+d 200`
+	)
+	want := []cmpRow{
+		{key: "500", cnts: []float64{1, 0}},
+		{key: "404", cnts: []float64{1, 1}},
+		{key: "200", cnts: []float64{1, 3}},
+	}
+	h := &histogrammer{
+		keys: []int{2},
+		ifs:  regexp.MustCompile(" +"),
+	}
+	have, err := h.compare([]string{"mon", "tue"}, []io.Reader{
+		bytes.NewBufferString(in1),
+		bytes.NewBufferString(in2),
+	})
+	if err != nil {
+		t.Fatalf("h.compare returned unexpected error=%v", err)
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("compare(...) returned bad results.\nhave=%v\nwant=%v", have, want)
+	}
+	if !reflect.DeepEqual(h.series, []string{"mon", "tue"}) {
+		t.Errorf("compare(...) series=%q, want=%q", h.series, []string{"mon", "tue"})
+	}
+
+	// Same data, distinguished by a column.
+	var both []string
+	for _, l := range strings.Split(in1, "\n") {
+		both = append(both, "mon "+l)
+	}
+	for _, l := range strings.Split(in2, "\n") {
+		both = append(both, "tue "+l)
+	}
+	h = &histogrammer{
+		keys:  []int{3},
+		byCol: 1,
+		ifs:   regexp.MustCompile(" +"),
+	}
+	have, err = h.compare(nil, []io.Reader{bytes.NewBufferString(strings.Join(both, "\n"))})
+	if err != nil {
+		t.Fatalf("h.compare returned unexpected error=%v", err)
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("compare(by=1) returned bad results.\nhave=%v\nwant=%v", have, want)
+	}
+	if !reflect.DeepEqual(h.series, []string{"mon", "tue"}) {
+		t.Errorf("compare(by=1) series=%q, want=%q", h.series, []string{"mon", "tue"})
+	}
+}
+
+func TestPrintCompare(t *testing.T) {
+	const in = `mon 404
+mon 200
+tue 404
+tue 200
+tue 200
+tue 200
+tue 500`
+	for _, d := range []struct {
+		ofs   string
+		scale gType
+		want  string
+	}{
+		{
+			scale: gLinear,
+			want: strings.Join([]string{
+				"       mon        tue      delta      ratio key",
+				"         0          1         +1          - 500   ++",
+				"         1          1         +0       1.00 404",
+				"         1          3         +2       3.00 200   ++++",
+				""}, "\n"),
+		},
+		{
+			ofs:   ",",
+			scale: gNone,
+			want: strings.Join([]string{
+				"mon,tue,delta,ratio,key",
+				"0,1,+1,-,500",
+				"1,1,+0,1.00,404",
+				"1,3,+2,3.00,200",
+				""}, "\n"),
+		},
+	} {
+		h := &histogrammer{
+			keys:      []int{2},
+			byCol:     1,
+			delta:     true,
+			ratio:     true,
+			ifs:       regexp.MustCompile(" +"),
+			ofs:       d.ofs,
+			termWidth: 54,
+			gt:        d.scale,
+		}
+		rows, err := h.compare(nil, []io.Reader{bytes.NewBufferString(in)})
+		if err != nil {
+			t.Fatalf("h.compare returned unexpected error=%v", err)
+		}
+		haveRaw := &bytes.Buffer{}
+		if err = h.printCompare(haveRaw, rows); err != nil {
+			t.Fatalf("h.printCompare returned unexpected error=%v", err)
+		}
+		if have := haveRaw.String(); have != d.want {
+			t.Errorf("printCompare (ofs=%q) returned bad results.\nhave=%q\nwant=%q", d.ofs, have, d.want)
+		}
+	}
+}
+
+func TestCmpHeaderOFS(t *testing.T) {
+	const in = `mon GET 200
+tue GET 200
+tue POST 500`
+	for _, d := range []struct {
+		keys         []int
+		delta, ratio bool
+		gt           gType
+		want         string
+	}{
+		{[]int{2, 3}, false, false, gLinear, "mon,tue,key1,key2,"},
+		{[]int{2, 3}, false, false, gNone, "mon,tue,key1,key2"},
+		{[]int{2}, true, true, gLinear, "mon,tue,delta,ratio,key,"},
+		{[]int{2}, true, true, gNone, "mon,tue,delta,ratio,key"},
+	} {
+		h := &histogrammer{
+			keys:      d.keys,
+			kjoin:     " ",
+			byCol:     1,
+			ifs:       regexp.MustCompile(" +"),
+			ofs:       ",",
+			termWidth: 54,
+			delta:     d.delta,
+			ratio:     d.ratio,
+			gt:        d.gt,
+		}
+		rows, err := h.compare(nil, []io.Reader{bytes.NewBufferString(in)})
+		if err != nil {
+			t.Fatalf("h.compare returned unexpected error=%v", err)
+		}
+		haveRaw := &bytes.Buffer{}
+		if err = h.printCompare(haveRaw, rows); err != nil {
+			t.Fatalf("h.printCompare returned unexpected error=%v", err)
+		}
+		lines := strings.Split(haveRaw.String(), "\n")
+		if lines[0] != d.want {
+			t.Errorf("printCompare(%v) header=%q, want=%q", d.keys, lines[0], d.want)
+		}
+		for _, l := range lines[1 : len(lines)-1] {
+			if have, want := strings.Count(l, ","), strings.Count(lines[0], ","); have != want {
+				t.Errorf("printCompare(%v) line %q has %d fields, want %d", d.keys, l, have+1, want+1)
+			}
+		}
+	}
+}
@@ -29,10 +29,66 @@ hist computes a histogram on its input.
   # You can set -ofs=, for CSV output, or \t for TSV.
   $ hist -k -w 3 -graph -ofs=\\t
 
-Output order is by increasing counts. To change order, pipe through sort and
-possibly use its -n and -k flags.
+  # Use the capture groups of a regexp as fields, for unstructured input.
+  # Without -k, the key is all groups.
+  $ hist -re 'status=(\d+)' < app.log
+  $ hist -re 'user=(?P<user>\S+) bytes=(\d+)' -k user -w 2 < app.log
+
+  # Only count lines matching a filter expression on their fields. With
+  # -header, the first line names fields and is otherwise skipped.
+  $ hist -k 2 -where '$1 ~ /^GET/ and $3 != 200' < access.log
+  $ hist -header -k 1 -where '$bytes > 1000' < table.txt
+
+  # Composite keys are aligned by field. With -ofs, each key field is a
+  # separate output field; -kjoin sets the string between them otherwise.
+  $ hist -k 1,2 -w 3 -scale=none < mydata
+       2 white  jumpsuit
+       5 blue   vest
+      42 orange vest
+
+  # Snippet long keys to fit, keeping the ends of paths, and size the key
+  # column to the widest key to leave the rest to the graph.
+  $ hist -k 7 -snippet -snipmode=path -kwidth=auto < access.log
+    1022 /static/…/js/app.min.js   +++++++++++++++++++++++++++++
+     ...
+
+  # Compare the distributions of two inputs, with a count column for each.
+  # The graph is not of the counts but of how much the last input differs
+  # from the first: bars of the delta, diverging around a centered axis. A
+  # ratio to a count of 0 in the first input is shown as -.
+  $ hist -k 9 -delta mon.log tue.log
+     mon.log    tue.log      delta key
+         501        498         -3 404
+        9922      10406       +484 200                     ++++++++++++++++++++++
+         ...
+
+  # Same, with the inputs distinguished by a column instead.
+  $ hist -k 9 -by 1 -delta -ratio < both.log
+
+  # Count requests per minute in a web server log. The timestamp may span
+  # several fields, which are joined by a space before parsing. Buckets are
+  # listed in time order, including empty ones.
+  $ hist -time 4,5 -tfmt clf -bucket 1m < access.log
+         12 2017-03-14 09:15 ++++++
+          0 2017-03-14 09:16
+         31 2017-03-14 09:17 +++++++++++++++
+         ...
 
-Currently only integer data is supported.
+  # Count unique client IPs, overall and per status code. With -approx, use
+  # HyperLogLog estimates, which take bounded memory however many there are.
+  $ hist -distinct 1 < access.log
+  18311
+  $ hist -k 9 -distinct 1 -approx < access.log
+
+Output order is by increasing counts (total counts, when comparing), or by
+time with -time. To change order, pipe through sort and possibly use its -n
+and -k flags.
+
+Weights may be human-readable numbers, such as 1.5K or 150ms; see -human.
+Weights, and so counts, may be negative. Graphs of negative counts (or deltas,
+when comparing) have an axis in the middle, with negative bars growing left
+and positive ones right. Log scale graphs are of log2(|count|+1), with the
+sign of the count.
 */
 package main
 
@@ -49,7 +105,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"unicode/utf8"
+	"time"
 
 	"github.com/gaal/shstat/internal"
 	"golang.org/x/crypto/ssh/terminal"
@@ -62,13 +118,32 @@ var (
 	words = flag.Bool("words", false, "tokenize input by unicode.IsSpace. Excludes -k and -w")
 
 	keyspec    = flag.String("k", "", "input key fields. Comma separated, or empty to use entire line")
+	extract    = flag.String("re", "", "take fields from the capture groups of this regexp instead of splitting by -ifs. Lines that do not match are skipped")
+	where      = flag.String("where", "", "only process lines matching this filter expression")
+	header     = flag.Bool("header", false, "input starts with a header line naming its fields")
+	keyjoin    = flag.String("kjoin", " ", "string to join key fields with, in auto formatting mode. With -ofs, key fields are separate output fields")
 	weightspec = flag.Int("w", 0, "weight column. Zero to use implicit weight 1 for all inputs. Negative values are allowed and count backwards from last column")
 
-	graph = flag.Bool("graph", true, "graph output")
+	timespec = flag.String("time", "", "timestamp fields, comma separated. Counts by time bucket instead of by key. Excludes -k and -words")
+	tfmt     = flag.String("tfmt", "auto", "timestamp format {auto, rfc3339, unix, unixms, clf, syslog} or a Go time layout")
+	bucket   = internal.DurationFlag("bucket", time.Minute, "time bucket width, with -time: a `duration` such as 1s, 5m, 1h or 1d")
+
+	distinct  = flag.String("distinct", "", "count distinct values of these fields, comma separated, per key instead of lines. Without -k or -time, prints a single count")
+	approx    = flag.Bool("approx", false, "with -distinct, estimate counts with HyperLogLog, in bounded memory")
+	precision = flag.Int("precision", 14, "with -approx, HyperLogLog precision: uses 2^precision bytes per key for a standard error of 1.04/sqrt(2^precision)")
+
+	byspec = flag.Int("by", 0, "series column for comparing distributions within a single input. Zero to compare input files instead")
+	delta  = flag.Bool("delta", false, "when comparing, show the difference between the last and the first series")
+	ratio  = flag.Bool("ratio", false, "when comparing, show the ratio of the last series to the first")
+
+	graph = flag.Bool("graph", true, "graph output. When comparing, graphs the delta between the last and the first series")
 	scale = flag.String("scale", "linear", "graph scale {log, linear}")
 
-	width   = flag.Int("width", 0, "terminal width (autodetect by default, fallback to 80)")
-	snippet = flag.Bool("snippet", false, "snippet long keys")
+	width    = flag.Int("width", 0, "terminal width (autodetect by default, fallback to 80)")
+	snippet  = flag.Bool("snippet", false, "snippet long keys")
+	snipmode = flag.String("snipmode", "head", "with -snippet, which part of long keys to keep {head, tail, middle, path}. path collapses directories in the middle of paths and URLs")
+	kwidth   = flag.String("kwidth", "", "key column width, in terminal cells. Empty for half the terminal, auto to fit the widest key")
+	human    = flag.String("human", "", "print counts in human-readable units {bytes, si, duration}")
 )
 
 const (
@@ -91,18 +166,40 @@ var termWidth = func() int {
 
 type histogrammer struct {
 	keys      []int
+	kjoin     string
 	weightCol int
 	words     bool
+	byCol     int
+
+	tcols  []int
+	tparse func(string) (time.Time, error)
+	bucket time.Duration
+
+	distinct []int                   // count distinct values of these fields instead of lines.
+	counter  func() internal.Counter // makes a distinct counter per key.
+
+	delta bool
+	ratio bool
 
 	termWidth int
 	ifs       *regexp.Regexp
+	match     bool // take fields from ifs capture groups.
+	filter    *internal.Filter
+	header    bool
 	ofs       string
 	gt        gType
 	snip      bool
-
-	gscale float64
-	maxVal int64
-	maxKey int
+	snipf     func(s string, width int) (string, bool) // snippets keys; nil for internal.Snip.
+	kwidth    int                                      // key column width; 0 for default, keyAuto to fit keys.
+	format    func(float64) string                     // formats counts; nil for plain numbers.
+
+	gscale  float64
+	diverge bool // graph negative values left of a centered axis.
+	maxVal  float64
+	maxKey  int
+	partw   []int                // widths of composite key fields.
+	btimes  map[string]time.Time // time buckets seen, by key.
+	series  []string
 
 	hfmt   string
 	kavail int
@@ -118,8 +215,9 @@ const (
 )
 
 type keyCount struct {
-	key string
-	cnt int64
+	key   string
+	parts []string // fields of a composite key, nil otherwise.
+	cnt   float64
 
 	// display fields: may be padded, snippeted etc.
 	dCnt, dKey, dGraph string
@@ -140,26 +238,137 @@ func (a byCountKey) Less(i, j int) bool {
 	return a[i].key < a[j].key
 }
 
-func abs(v int64) int64 {
-	if v >= 0 {
-		return v
+// fmtCount formats a count for display.
+func (h histogrammer) fmtCount(v float64) string {
+	if h.format == nil {
+		return internal.FormatNumber(v)
+	}
+	return h.format(v)
+}
+
+// snipKey returns a snippet of key that fits in the key column.
+func (h histogrammer) snipKey(key string) string {
+	snip := h.snipf
+	if snip == nil {
+		snip = internal.Snip
+	}
+	key, _ = snip(key, h.kavail)
+	return key
+}
+
+// keyAuto is the kwidth to fit the key column to the widest key.
+const keyAuto = -1
+
+// minGraph is the least graph width left when fitting the key column to keys.
+const minGraph = 10
+
+// keyWidth returns the display width of the widest key.
+func (h histogrammer) keyWidth() int {
+	if len(h.partw) == 0 {
+		return h.maxKey
+	}
+	n := (len(h.partw) - 1) * internal.Width(h.kjoin)
+	for _, w := range h.partw {
+		n += w
+	}
+	return n
+}
+
+// fitKeys sets the width of the key column per h.kwidth, once keys are laid
+// out. The graph column gets the rest of the space the two had.
+func (h *histogrammer) fitKeys() {
+	span := h.kavail // key and graph columns, and the separator between.
+	if h.gt != gNone {
+		span += 1 + h.gavail
+	}
+	kw := h.kavail
+	switch {
+	case h.kwidth > 0:
+		kw = h.kwidth
+	case h.kwidth == keyAuto:
+		kw = h.keyWidth()
+		max := span
+		if h.gt != gNone {
+			max = span - 1 - minGraph
+		}
+		if kw > max {
+			kw = max
+		}
+	}
+	if kw < 1 {
+		kw = 1
+	}
+	h.kavail = kw
+	if h.gt != gNone {
+		h.gavail = span - 1 - kw
+		if h.gavail < 0 {
+			h.gavail = 0
+		}
+	}
+}
+
+// pad pads s with spaces to width terminal cells in auto formatting mode, on
+// the left if right is set.
+func (h histogrammer) pad(s string, width int, right bool) string {
+	if h.ofs != "" {
+		return s
+	}
+	return internal.Pad(s, width, right)
+}
+
+// scaled returns v on the graph scale: linear or signed logarithmic, so
+// that zero and negative values can be graphed on log scale too.
+func (h histogrammer) scaled(v float64) float64 {
+	if h.gt == gLog {
+		return math.Copysign(math.Log2(math.Abs(v)+1), v)
+	}
+	return v
+}
+
+// setScale sets the graph scale to fit vals, the values to graph. If any are
+// negative, the graph diverges from an axis in its middle.
+func (h *histogrammer) setScale(vals []float64) {
+	h.maxVal = 0
+	h.diverge = false
+	for _, v := range vals {
+		h.maxVal = math.Max(h.maxVal, math.Abs(v))
+		h.diverge = h.diverge || v < 0
+	}
+	h.gscale = math.Abs(h.scaled(h.maxVal))
+	if h.gscale == 0 { // nothing to graph: avoid drawing NaNs.
+		h.gscale = 1
+	}
+	h.gscale /= float64(h.side())
+}
+
+// side returns the width of the graph on either side of the axis, when
+// diverging, or the whole graph width otherwise.
+func (h histogrammer) side() int {
+	if h.diverge {
+		return (h.gavail - 1) / 2
 	}
-	return -v
+	return h.gavail
 }
 
-// snip returns a snippet of s at most width runes long, along with a bool
-// reporting whether snippeting has occurred.
-func snip(s string, width int) (string, bool) {
-	const snipMark = "…"
-	for i, w := 0, 0; i < len(s); i += w {
-		if width <= 1 {
-			return s[:i] + snipMark, true
+// bar returns the graph bar for v. When diverging, bars for negative values
+// grow left from the axis and those for positive values grow right. With an
+// output delimiter, bars are not padded to line up the axis.
+func (h histogrammer) bar(v float64) string {
+	g := h.gv(h.scaled(v) / h.gscale)
+	if !h.diverge {
+		return g
+	}
+	side := h.side()
+	if h.ofs != "" {
+		side = len(g)
+		if v >= 0 {
+			side = 0
 		}
-		width--
-		_, rw := utf8.DecodeRuneInString(s[i:])
-		w = rw
 	}
-	return s, false
+	if v < 0 {
+		return strings.Repeat(" ", side-len(g)) + g + "|"
+	}
+	return strings.Repeat(" ", side) + "|" + g
 }
 
 func (h histogrammer) gv(v float64) string {
@@ -176,67 +385,157 @@ func (h histogrammer) gv(v float64) string {
 
 // hlinefmt prepares a format string for records in a histogram, as well as max
 // available key and graph width, according to the given terminal and display
-// options.
+// options. Fields are padded to width before formatting, as the widths of %s
+// verbs count runes rather than terminal cells.
 func hlinefmt(tw int, graph bool, ofs string) (hfmt string, kavail int, gavail int) {
 	sep := strings.Replace(ofs, "%", "%%", -1)
-	cfmt := func(avail int) string { return "%d" }
-	kfmt := func(avail int) string { return "%s" }
 	if ofs == "" { // autoformatting
 		sep = " "
-		cfmt = func(avail int) string { return "%" + strconv.Itoa(avail) + "d" }
-		kfmt = func(avail int) string { return "%-" + strconv.Itoa(avail) + "s" }
 	}
 	if graph {
 		kavail = tw/2 - countAvail - 1
 		gavail = tw - countAvail - kavail - 3
-		hfmt = strings.Join([]string{cfmt(countAvail), kfmt(kavail), "%s"}, sep)
+		hfmt = strings.Join([]string{"%s", "%s", "%s"}, sep)
 	} else {
 		kavail = tw - countAvail - 2
-		hfmt = strings.Join([]string{cfmt(countAvail), kfmt(kavail)}, sep)
+		hfmt = strings.Join([]string{"%s", "%s"}, sep)
 	}
 	return
 }
 
+// keySep separates the fields of composite keys internally.
+const keySep = "\x00"
+
+// splitKey splits an internal key, as passed by scan, into its display form
+// and its fields if composite.
+func (h histogrammer) splitKey(k string) (string, []string) {
+	if len(h.keys) < 2 {
+		return k, nil
+	}
+	parts := strings.Split(k, keySep)
+	return strings.Join(parts, h.kjoin), parts
+}
+
+// layoutKeys computes the widths of composite key fields, for display.
+func (h *histogrammer) layoutKeys(keys [][]string) {
+	h.partw = nil
+	for _, parts := range keys {
+		for i, p := range parts {
+			if i >= len(h.partw) {
+				h.partw = append(h.partw, 0)
+			}
+			if n := internal.Width(p); n > h.partw[i] {
+				h.partw[i] = n
+			}
+		}
+	}
+}
+
+// dispKey returns the display form of a key. Composite keys are aligned by
+// field in auto formatting mode, and emitted as separate fields otherwise.
+func (h histogrammer) dispKey(key string, parts []string) string {
+	if len(parts) == 0 {
+		return key
+	}
+	if h.ofs != "" {
+		return strings.Join(parts, h.ofs)
+	}
+	padded := make([]string, len(parts))
+	for i, p := range parts {
+		if i < len(parts)-1 && i < len(h.partw) {
+			p = internal.Pad(p, h.partw[i], false)
+		}
+		padded[i] = p
+	}
+	return strings.Join(padded, h.kjoin)
+}
+
 func (h histogrammer) hline(kc keyCount) string {
+	kc.key = h.dispKey(kc.key, kc.parts)
 	if h.snip {
-		kc.key, _ = snip(kc.key, h.kavail)
+		kc.key = h.snipKey(kc.key)
 	}
+	cnt := h.pad(h.fmtCount(kc.cnt), countAvail, true)
+	kc.key = h.pad(kc.key, h.kavail, false)
 	if h.gt == gNone {
-		return strings.TrimRight(fmt.Sprintf(h.hfmt, kc.cnt, kc.key), " ")
+		return strings.TrimRight(fmt.Sprintf(h.hfmt, cnt, kc.key), " ")
 	}
-	var g float64
-	switch h.gt {
-	case gLinear:
-		g = float64(kc.cnt) / h.gscale
-	case gLog:
-		g = math.Log2(float64(kc.cnt)) / h.gscale
+	return strings.TrimRight(fmt.Sprintf(h.hfmt, cnt, kc.key, h.bar(kc.cnt)), " ")
+}
+
+// timeKeys returns the keys of all time buckets from the first to the last
+// seen, in order.
+func (h histogrammer) timeKeys() ([]string, error) {
+	if len(h.btimes) == 0 {
+		return nil, nil
+	}
+	var first, last time.Time
+	for _, t := range h.btimes {
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if t.After(last) {
+			last = t
+		}
 	}
-	return strings.TrimRight(fmt.Sprintf(h.hfmt, kc.cnt, kc.key, h.gv(g)), " ")
+	ts, err := internal.TimeBuckets(first, last, h.bucket)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(ts))
+	for i, t := range ts {
+		keys[i] = internal.FormatBucket(t, h.bucket)
+	}
+	return keys, nil
 }
 
-func (h *histogrammer) hist(in io.Reader) ([]keyCount, error) {
-	h.hfmt, h.kavail, h.gavail = hlinefmt(h.termWidth, h.gt != gNone, h.ofs)
+// parter returns a Parter for the given fields of input lines.
+func (h histogrammer) parter(idx []int) *internal.Parter {
+	if h.match {
+		return internal.NewMatchParter(h.ifs, idx)
+	}
+	return internal.NewParter(h.ifs, idx)
+}
 
-	key := func(line []byte) []byte { return line }
+// scan reads records from in and calls add with the key and weight of each of
+// them, as well as the line the record came from.
+func (h *histogrammer) scan(in io.Reader, add func(k string, w float64, line []byte)) error {
+	key := func(line []byte) ([]byte, error) { return line, nil }
+	if h.counter != nil { // a single group, unless keyed below.
+		key = func(line []byte) ([]byte, error) { return nil, nil }
+	}
 	if len(h.keys) > 0 {
-		kp := internal.NewParter(h.ifs, h.keys)
-		key = func(line []byte) []byte {
-			parts := kp.Fields(line)
-			// TODO: is there a better way to rejoin parted keys than hardcode
-			// space? ofs is wrong, but ifs is a regexp.
-			return bytes.Join(parts, []byte(" "))
+		kp := h.parter(h.keys)
+		key = func(line []byte) ([]byte, error) {
+			return bytes.Join(kp.Fields(line), []byte(keySep)), nil
+		}
+	}
+	if h.tparse != nil {
+		if h.btimes == nil {
+			h.btimes = make(map[string]time.Time)
+		}
+		tp := h.parter(h.tcols)
+		key = func(line []byte) ([]byte, error) {
+			t, err := h.tparse(string(bytes.Join(tp.Fields(line), []byte(" "))))
+			if err != nil {
+				return nil, err
+			}
+			t = internal.TimeBucket(t, h.bucket)
+			k := internal.FormatBucket(t, h.bucket)
+			h.btimes[k] = t
+			return []byte(k), nil
 		}
 	}
 
-	weight := func(line []byte) (int64, error) { return 1, nil }
+	weight := func(line []byte) (float64, error) { return 1, nil }
 	if h.weightCol != 0 {
-		wp := internal.NewParter(h.ifs, []int{h.weightCol})
-		weight = func(line []byte) (int64, error) {
+		wp := h.parter([]int{h.weightCol})
+		weight = func(line []byte) (float64, error) {
 			parts := wp.Fields(line)
 			if len(parts) == 0 {
 				return 0, errors.New("short line")
 			}
-			w, err := strconv.ParseInt(string(parts[0]), 10, 64)
+			w, err := internal.ParseNumber(string(parts[0]))
 			if err != nil {
 				return 0, err
 			}
@@ -244,7 +543,6 @@ func (h *histogrammer) hist(in io.Reader) ([]keyCount, error) {
 		}
 	}
 
-	d := make(map[string]int64)
 	var nlines int
 	s := bufio.NewScanner(in)
 	if h.words {
@@ -254,37 +552,98 @@ func (h *histogrammer) hist(in io.Reader) ([]keyCount, error) {
 		nlines++
 
 		line := s.Bytes()
+		if nlines == 1 && h.header {
+			if err := h.filter.SetHeader(line); err != nil {
+				return err
+			}
+			continue
+		}
+		if h.match && !h.ifs.Match(line) || !h.filter.Match(line) {
+			continue
+		}
 		w, err := weight(line)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "%v: %d", err, nlines)
 			continue
 		}
-		k := string(key(line))
-		d[k] += w
-		if len(k) > h.maxKey {
-			h.maxKey = len(k)
+		kb, err := key(line)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %d\n", err, nlines)
+			continue
+		}
+		k := string(kb)
+		add(k, w, line)
+		if n := internal.Width(k); n > h.maxKey {
+			h.maxKey = n
+		}
+	}
+	return s.Err()
+}
+
+// empty reports whether all of parts are empty or missing.
+func empty(parts [][]byte) bool {
+	for _, p := range parts {
+		if len(p) > 0 {
+			return false
 		}
 	}
-	if err := s.Err(); err != nil {
+	return true
+}
+
+func (h *histogrammer) hist(in io.Reader) ([]keyCount, error) {
+	h.hfmt, h.kavail, h.gavail = hlinefmt(h.termWidth, h.gt != gNone, h.ofs)
+
+	d := make(map[string]float64)
+	if h.counter != nil {
+		dp := h.parter(h.distinct)
+		cs := make(map[string]internal.Counter)
+		err := h.scan(in, func(k string, _ float64, line []byte) {
+			v := dp.Fields(line)
+			if empty(v) {
+				return // the line lacks the distinct fields.
+			}
+			c := cs[k]
+			if c == nil {
+				c = h.counter()
+				cs[k] = c
+			}
+			c.Add(bytes.Join(v, []byte(keySep)))
+		})
+		if err != nil {
+			return nil, err
+		}
+		for k, c := range cs {
+			d[k] = float64(c.Count())
+		}
+	} else if err := h.scan(in, func(k string, w float64, _ []byte) { d[k] += w }); err != nil {
 		return nil, err
 	}
 
 	var kc []keyCount
-	for k, v := range d {
-		kc = append(kc, keyCount{key: k, cnt: v})
-	}
-	sort.Sort(byCountKey(kc))
-	if len(kc) > 0 {
-		h.maxVal = abs(kc[0].cnt)
-		if abs(kc[len(kc)-1].cnt) > h.maxVal {
-			h.maxVal = abs(kc[len(kc)-1].cnt)
+	var parts [][]string
+	if h.tparse != nil {
+		keys, err := h.timeKeys()
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			kc = append(kc, keyCount{key: k, cnt: d[k]})
+		}
+	} else {
+		for k, v := range d {
+			key, p := h.splitKey(k)
+			kc = append(kc, keyCount{key: key, parts: p, cnt: v})
+			parts = append(parts, p)
 		}
+		sort.Sort(byCountKey(kc))
 	}
-	h.gscale = float64(h.maxVal)
-	if h.gt == gLog {
-		h.gscale = math.Log2(float64(h.maxVal))
+	h.layoutKeys(parts)
+	h.fitKeys()
+	var vals []float64
+	for _, v := range kc {
+		vals = append(vals, v.cnt)
 	}
-	h.gscale /= float64(h.gavail)
+	h.setScale(vals)
 
 	return kc, nil
 }
@@ -330,10 +689,66 @@ func main() {
   # You can set -ofs=, for CSV output, or \t for TSV.
   $ hist -k -w 3 -graph -ofs=\\t
 
-Output order is by increasing counts. To change order, pipe through sort and
-possibly use its -n and -k flags.
+  # Use the capture groups of a regexp as fields, for unstructured input.
+  # Without -k, the key is all groups.
+  $ hist -re 'status=(\d+)' < app.log
+  $ hist -re 'user=(?P<user>\S+) bytes=(\d+)' -k user -w 2 < app.log
+
+  # Only count lines matching a filter expression on their fields. With
+  # -header, the first line names fields and is otherwise skipped.
+  $ hist -k 2 -where '$1 ~ /^GET/ and $3 != 200' < access.log
+  $ hist -header -k 1 -where '$bytes > 1000' < table.txt
+
+  # Composite keys are aligned by field. With -ofs, each key field is a
+  # separate output field; -kjoin sets the string between them otherwise.
+  $ hist -k 1,2 -w 3 -scale=none < mydata
+       2 white  jumpsuit
+       5 blue   vest
+      42 orange vest
+
+  # Snippet long keys to fit, keeping the ends of paths, and size the key
+  # column to the widest key to leave the rest to the graph.
+  $ hist -k 7 -snippet -snipmode=path -kwidth=auto < access.log
+    1022 /static/…/js/app.min.js   +++++++++++++++++++++++++++++
+     ...
+
+  # Compare the distributions of two inputs, with a count column for each.
+  # The graph is not of the counts but of how much the last input differs
+  # from the first: bars of the delta, diverging around a centered axis. A
+  # ratio to a count of 0 in the first input is shown as -.
+  $ hist -k 9 -delta mon.log tue.log
+     mon.log    tue.log      delta key
+         501        498         -3 404
+        9922      10406       +484 200                     ++++++++++++++++++++++
+         ...
+
+  # Same, with the inputs distinguished by a column instead.
+  $ hist -k 9 -by 1 -delta -ratio < both.log
+
+  # Count requests per minute in a web server log. The timestamp may span
+  # several fields, which are joined by a space before parsing. Buckets are
+  # listed in time order, including empty ones.
+  $ hist -time 4,5 -tfmt clf -bucket 1m < access.log
+         12 2017-03-14 09:15 ++++++
+          0 2017-03-14 09:16
+         31 2017-03-14 09:17 +++++++++++++++
+         ...
 
-Currently only integer data is supported.`)
+  # Count unique client IPs, overall and per status code. With -approx, use
+  # HyperLogLog estimates, which take bounded memory however many there are.
+  $ hist -distinct 1 < access.log
+  18311
+  $ hist -k 9 -distinct 1 -approx < access.log
+
+Output order is by increasing counts (total counts, when comparing), or by
+time with -time. To change order, pipe through sort and possibly use its -n
+and -k flags.
+
+Weights may be human-readable numbers, such as 1.5K or 150ms; see -human.
+Weights, and so counts, may be negative. Graphs of negative counts (or deltas,
+when comparing) have an axis in the middle, with negative bars growing left
+and positive ones right. Log scale graphs are of log2(|count|+1), with the
+sign of the count.`)
 	flag.Parse()
 
 	*outDelim = strings.Replace(*outDelim, `\t`, "\t", -1)
@@ -343,16 +758,101 @@ Currently only integer data is supported.`)
 	if len(keysstr) == 1 && keysstr[0] == "" {
 		keysstr = nil
 	}
-	keys, err := internal.AtoiList(keysstr)
+	var keys []int
+	var err error
+	if *extract != "" {
+		ifs = regexp.MustCompile(*extract)
+		keys, err = internal.SubexpList(ifs, keysstr)
+		if len(keys) == 0 && *timespec == "" && *distinct == "" { // key on all groups.
+			keys = []int{1}
+			for i := 2; i <= ifs.NumSubexp(); i++ {
+				keys = append(keys, i)
+			}
+		}
+	} else {
+		keys, err = internal.AtoiList(keysstr)
+	}
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
-	if *words && (len(keys) > 0 || *weightspec != 0) {
-		fmt.Fprintln(os.Stderr, "--words cannot be used with -k or -w")
+	if *words && (len(keys) > 0 || *weightspec != 0 || *where != "" || *header) {
+		fmt.Fprintln(os.Stderr, "--words cannot be used with -k, -w, -re, -where or -header")
+		os.Exit(1)
+	}
+	if *words && *byspec != 0 {
+		fmt.Fprintln(os.Stderr, "--words cannot be used with -by")
+		os.Exit(1)
+	}
+	var tcols []int
+	if *timespec != "" {
+		if len(keys) > 0 || *words {
+			fmt.Fprintln(os.Stderr, "-time cannot be used with -k or -words")
+			os.Exit(1)
+		}
+		if *bucket <= 0 {
+			fmt.Fprintf(os.Stderr, "bad -bucket=%v\n", *bucket)
+			os.Exit(1)
+		}
+		tstr := strings.Split(*timespec, ",")
+		if *extract != "" {
+			tcols, err = internal.SubexpList(ifs, tstr)
+		} else {
+			tcols, err = internal.AtoiList(tstr)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	var dcols []int
+	if *distinct != "" {
+		if *words || *weightspec != 0 {
+			fmt.Fprintln(os.Stderr, "-distinct cannot be used with -words or -w")
+			os.Exit(1)
+		}
+		if *byspec != 0 || flag.NArg() > 1 {
+			fmt.Fprintln(os.Stderr, "-distinct cannot be used when comparing")
+			os.Exit(1)
+		}
+		dstr := strings.Split(*distinct, ",")
+		if *extract != "" {
+			dcols, err = internal.SubexpList(ifs, dstr)
+		} else {
+			dcols, err = internal.AtoiList(dstr)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if *approx {
+			if _, err := internal.NewHyperLogLog(*precision); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+	}
+	if *byspec != 0 && flag.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "-by cannot be used with multiple inputs")
 		os.Exit(1)
 	}
 
+	names := flag.Args()
+	var ins []io.Reader
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		ins = append(ins, f)
+	}
+	if len(ins) == 0 {
+		names = []string{"-"}
+		ins = []io.Reader{os.Stdin}
+	}
+
 	var gtype gType
 	switch *scale {
 	case "none":
@@ -365,25 +865,95 @@ Currently only integer data is supported.`)
 		fmt.Fprintf(os.Stderr, "bad -scale=%q\n", *scale)
 		os.Exit(1)
 	}
+	format, err := internal.Formatter(*human)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	snipf, err := internal.Snipper(*snipmode)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var kw int
+	switch *kwidth {
+	case "":
+	case "auto":
+		kw = keyAuto
+	default:
+		if kw, err = strconv.Atoi(*kwidth); err != nil || kw < 1 {
+			fmt.Fprintf(os.Stderr, "bad -kwidth=%q\n", *kwidth)
+			os.Exit(1)
+		}
+	}
 	tw := termWidth()
 	if tw < 20 {
 		tw = 20
 	}
 	h := &histogrammer{
 		keys:      keys,
+		kjoin:     *keyjoin,
 		weightCol: *weightspec,
 		words:     *words,
 		gt:        gtype,
 		ifs:       ifs,
+		match:     *extract != "",
+		header:    *header,
 		ofs:       *outDelim,
 		termWidth: tw,
 		snip:      *snippet,
+		snipf:     snipf,
+		kwidth:    kw,
+		format:    format,
+		byCol:     *byspec,
+		delta:     *delta,
+		ratio:     *ratio,
+	}
+	if tcols != nil {
+		h.tcols = tcols
+		h.tparse = internal.TimeParser(*tfmt)
+		h.bucket = *bucket
+	}
+	if dcols != nil {
+		h.distinct = dcols
+		h.counter = func() internal.Counter { return internal.ExactCounter{} }
+		if *approx {
+			p := *precision
+			h.counter = func() internal.Counter {
+				c, _ := internal.NewHyperLogLog(p) // p is checked above.
+				return c
+			}
+		}
+	}
+	if h.filter, err = internal.ParseFilter(*where, h.parter(nil), h.header); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if len(ins) > 1 || h.byCol != 0 {
+		rows, err := h.compare(names, ins)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err = h.printCompare(os.Stdout, rows); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
 	}
-	kc, err := h.hist(os.Stdin)
+	kc, err := h.hist(ins[0])
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	if h.counter != nil && len(h.keys) == 0 && h.tparse == nil {
+		var n float64
+		if len(kc) > 0 {
+			n = kc[0].cnt
+		}
+		fmt.Println(h.fmtCount(n))
+		return
+	}
 	if err = h.printHist(os.Stdout, kc); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -7,6 +7,9 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/gaal/shstat/internal"
 )
 
 func norm(s string) string {
@@ -32,13 +35,13 @@ func TestHlinefmt(t *testing.T) {
 		wantGavail int
 	}{
 		{tw: 40,
-			wantHfmt: "%15d %-23s", wantKavail: 23},
+			wantHfmt: "%s %s", wantKavail: 23},
 		{tw: 40, ofs: ",",
-			wantHfmt: "%d,%s", wantKavail: 23},
+			wantHfmt: "%s,%s", wantKavail: 23},
 		{tw: 60, graph: true,
-			wantHfmt: "%15d %-14s %s", wantKavail: 14, wantGavail: 28},
+			wantHfmt: "%s %s %s", wantKavail: 14, wantGavail: 28},
 		{tw: 60, graph: true, ofs: ",",
-			wantHfmt: "%d,%s,%s", wantKavail: 14, wantGavail: 28},
+			wantHfmt: "%s,%s,%s", wantKavail: 14, wantGavail: 28},
 	} {
 		hfmt, kavail, gavail := hlinefmt(d.tw, d.graph, d.ofs)
 		if hfmt != d.wantHfmt {
@@ -65,7 +68,7 @@ a c 5`
 		{nil, 0,
 			[]keyCount{kc("a b 2", 1), kc("a c 5", 1)}},
 		{[]int{1, 2}, -1,
-			[]keyCount{kc("a b", 2), kc("a c", 5)}},
+			[]keyCount{kcp("a b", 2), kcp("a c", 5)}},
 		{[]int{1}, 0,
 			[]keyCount{kc("a", 2)}},
 		{[]int{1}, -1,
@@ -73,6 +76,7 @@ a c 5`
 	} {
 		h := &histogrammer{
 			keys:      d.keys,
+			kjoin:     " ",
 			weightCol: d.weightCol,
 			ifs:       ifs,
 		}
@@ -86,7 +90,68 @@ a c 5`
 	}
 }
 
-func kc(k string, c int64) keyCount { return keyCount{key: k, cnt: c} }
+func kc(k string, c float64) keyCount { return keyCount{key: k, cnt: c} }
+
+// kcp is like kc, for composite keys of space-separated fields.
+func kcp(k string, c float64) keyCount {
+	return keyCount{key: k, parts: strings.Split(k, " "), cnt: c}
+}
+
+func TestCompositeKeys(t *testing.T) {
+	const in = `GET /a/long/path 200
+POST /b 200
+GET /b 404
+POST /b 200`
+	for _, d := range []struct {
+		kjoin string
+		ofs   string
+		want  string
+	}{
+		{
+			kjoin: " ",
+			want: strings.Join([]string{
+				"              1 GET  /a/long/path 200",
+				"              1 GET  /b           404",
+				"              2 POST /b           200",
+				""}, "\n"),
+		},
+		{
+			kjoin: " | ",
+			want: strings.Join([]string{
+				"              1 GET  | /a/long/path | 200",
+				"              1 GET  | /b           | 404",
+				"              2 POST | /b           | 200",
+				""}, "\n"),
+		},
+		{
+			kjoin: " ", ofs: ",",
+			want: strings.Join([]string{
+				"1,GET,/a/long/path,200",
+				"1,GET,/b,404",
+				"2,POST,/b,200",
+				""}, "\n"),
+		},
+	} {
+		h := &histogrammer{
+			keys:      []int{1, 2, 3},
+			kjoin:     d.kjoin,
+			ifs:       regexp.MustCompile(" +"),
+			ofs:       d.ofs,
+			termWidth: 60,
+		}
+		data, err := h.hist(bytes.NewBufferString(in))
+		if err != nil {
+			t.Fatalf("h.hist returned unexpected error=%v", err)
+		}
+		haveRaw := &bytes.Buffer{}
+		if err = h.printHist(haveRaw, data); err != nil {
+			t.Fatalf("h.printHist returned unexpected error=%v", err)
+		}
+		if have := haveRaw.String(); have != d.want {
+			t.Errorf("hist (kjoin=%q, ofs=%q) returned bad results.\nhave=%q\nwant=%q", d.kjoin, d.ofs, have, d.want)
+		}
+	}
+}
 
 func TestWords(t *testing.T) {
 	const in = `... What
@@ -126,6 +191,7 @@ c 100
 z_long_key_that_is_snippetted 100`
 	for _, d := range []struct {
 		scale gType
+		ofs   string
 		want  string
 	}{
 		{
@@ -142,23 +208,35 @@ z_long_key_that_is_snippetted 100`
 		{
 			scale: gLinear,
 			want: strings.Join([]string{
-				"            -10 -    --",
-				"              0 0",
-				"              1 a",
-				"             10 b    ++",
-				"            100 c    ++++++++++++++++++",
-				"            100 z_l… ++++++++++++++++++",
+				"            -10 -           -|",
+				"              0 0            |",
+				"              1 a            |",
+				"             10 b            |+",
+				"            100 c            |++++++++",
+				"            100 z_l…         |++++++++",
 				""}, "\n"),
 		},
 		{
 			scale: gLog,
 			want: strings.Join([]string{
-				"            -10 -    NaN",
-				"              0 0    -Inf",
-				"              1 a",
-				"             10 b    +++++++++",
-				"            100 c    ++++++++++++++++++",
-				"            100 z_l… ++++++++++++++++++",
+				"            -10 -        ----|",
+				"              0 0            |",
+				"              1 a            |+",
+				"             10 b            |++++",
+				"            100 c            |++++++++",
+				"            100 z_l…         |++++++++",
+				""}, "\n"),
+		},
+		{
+			scale: gLinear,
+			ofs:   ",",
+			want: strings.Join([]string{
+				"-10,-,-|",
+				"0,0,|",
+				"1,a,|",
+				"10,b,|+",
+				"100,c,|++++++++",
+				"100,z_l…,|++++++++",
 				""}, "\n"),
 		},
 	} {
@@ -166,6 +244,7 @@ z_long_key_that_is_snippetted 100`
 			keys:      []int{1},
 			weightCol: 2,
 			ifs:       regexp.MustCompile(" +"),
+			ofs:       d.ofs,
 			termWidth: 40,
 			snip:      true,
 			gt:        d.scale,
@@ -196,3 +275,322 @@ func TestTermWidth(t *testing.T) {
 		t.Errorf("termWidth()=%d, want > 0", w)
 	}
 }
+
+func TestHistMatch(t *testing.T) {
+	const in = `GET /a status=200 bytes=10
+GET /b status=404
+no status here
+GET /c status=200 bytes=5`
+	h := &histogrammer{
+		keys:  []int{1},
+		ifs:   regexp.MustCompile(`status=(\d+)`),
+		match: true,
+	}
+	have, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	want := []keyCount{kc("404", 1), kc("200", 2)}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("hist(match) returned bad results.\nhave=%v\nwant=%v", have, want)
+	}
+}
+
+func TestHistWhere(t *testing.T) {
+	const in = `method status
+GET 200
+GET 404
+POST 200
+GET 500`
+	p := internal.NewParter(regexp.MustCompile(" +"), nil)
+	f, err := internal.ParseFilter(`$method == GET && $status >= 400`, p, true)
+	if err != nil {
+		t.Fatalf("ParseFilter returned unexpected error=%v", err)
+	}
+	h := &histogrammer{
+		keys:   []int{2},
+		ifs:    regexp.MustCompile(" +"),
+		filter: f,
+		header: true,
+	}
+	have, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	want := []keyCount{kc("404", 1), kc("500", 1)}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("hist(where) returned bad results.\nhave=%v\nwant=%v", have, want)
+	}
+}
+
+func TestHistHuman(t *testing.T) {
+	const in = `a 1.5K
+b 512B
+a 2,048
+b 1KiB`
+	h := &histogrammer{
+		keys:      []int{1},
+		weightCol: 2,
+		ifs:       regexp.MustCompile(" +"),
+		termWidth: 40,
+		format:    internal.FormatBytes,
+	}
+	data, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	if want := []keyCount{kc("b", 1536), kc("a", 3584)}; !reflect.DeepEqual(data, want) {
+		t.Errorf("hist(human) returned bad results.\nhave=%v\nwant=%v", data, want)
+	}
+	haveRaw := &bytes.Buffer{}
+	if err = h.printHist(haveRaw, data); err != nil {
+		t.Fatalf("h.printHist returned unexpected error=%v", err)
+	}
+	want := strings.Join([]string{
+		"           1.5K b",
+		"           3.5K a",
+		""}, "\n")
+	if have := haveRaw.String(); have != want {
+		t.Errorf("hist(human) printed bad results.\nhave=%q\nwant=%q", have, want)
+	}
+}
+
+func TestHistTime(t *testing.T) {
+	const in = `GET [14/Mar/2017:09:15:26 +0000] 200
+GET [14/Mar/2017:09:15:59 +0000] 200
+GET [14/Mar/2017:09:17:01 +0000] 500
+GET garbage 200
+GET [14/Mar/2017:09:18:30 +0000] 200`
+	h := &histogrammer{
+		ifs:       regexp.MustCompile(" +"),
+		tcols:     []int{2, 3},
+		tparse:    internal.TimeParser("clf"),
+		bucket:    time.Minute,
+		termWidth: 40,
+		format:    internal.FormatNumber,
+	}
+	data, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	want := []keyCount{
+		kc("2017-03-14 09:15", 2),
+		kc("2017-03-14 09:16", 0),
+		kc("2017-03-14 09:17", 1),
+		kc("2017-03-14 09:18", 1),
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("hist(time) returned bad results.\nhave=%v\nwant=%v", data, want)
+	}
+}
+
+func TestHistTimeDays(t *testing.T) {
+	const in = `GET [14/Mar/2017:09:15:26 +0000] 200
+GET [14/Mar/2017:23:59:59 +0000] 200
+GET [16/Mar/2017:00:00:00 +0000] 500`
+	bucket, err := internal.ParseDuration("1d")
+	if err != nil {
+		t.Fatalf("ParseDuration(1d) returned unexpected error=%v", err)
+	}
+	h := &histogrammer{
+		ifs:       regexp.MustCompile(" +"),
+		tcols:     []int{2, 3},
+		tparse:    internal.TimeParser("clf"),
+		bucket:    bucket,
+		termWidth: 40,
+		format:    internal.FormatNumber,
+	}
+	data, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	want := []keyCount{
+		kc("2017-03-14", 2),
+		kc("2017-03-15", 0),
+		kc("2017-03-16", 1),
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("hist(time, 1d) returned bad results.\nhave=%v\nwant=%v", data, want)
+	}
+}
+
+func TestHistDistinct(t *testing.T) {
+	const in = `1.1.1.1 200
+2.2.2.2 200
+1.1.1.1 200
+1.1.1.1 404
+3.3.3.3 200`
+	for _, d := range []struct {
+		keys []int
+		want []keyCount
+	}{
+		{nil, []keyCount{kc("", 3)}},
+		{[]int{2}, []keyCount{kc("404", 1), kc("200", 3)}},
+	} {
+		h := &histogrammer{
+			keys:      d.keys,
+			ifs:       regexp.MustCompile(" +"),
+			distinct:  []int{1},
+			counter:   func() internal.Counter { return internal.ExactCounter{} },
+			termWidth: 40,
+		}
+		data, err := h.hist(bytes.NewBufferString(in))
+		if err != nil {
+			t.Fatalf("h.hist returned unexpected error=%v", err)
+		}
+		if !reflect.DeepEqual(data, d.want) {
+			t.Errorf("hist(distinct, keys=%v) returned bad results.\nhave=%v\nwant=%v", d.keys, data, d.want)
+		}
+	}
+}
+
+func TestHistDistinctMissing(t *testing.T) {
+	// The last line lacks the distinct field, and is not counted.
+	const in = `1 x
+1 y
+2 x
+1 x
+3`
+	h := &histogrammer{
+		ifs:       regexp.MustCompile(" +"),
+		distinct:  []int{2},
+		counter:   func() internal.Counter { return internal.ExactCounter{} },
+		termWidth: 40,
+	}
+	data, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	if want := []keyCount{kc("", 2)}; !reflect.DeepEqual(data, want) {
+		t.Errorf("hist(distinct 2) returned bad results.\nhave=%v\nwant=%v", data, want)
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	const in = `東京
+東京
+naïve
+😀😀😀😀😀😀😀😀😀😀😀😀`
+	h := &histogrammer{
+		ifs:       regexp.MustCompile(" +"),
+		termWidth: 60,
+		gt:        gLinear,
+		snip:      true,
+	}
+	data, err := h.hist(bytes.NewBufferString(in))
+	if err != nil {
+		t.Fatalf("h.hist returned unexpected error=%v", err)
+	}
+	have := &bytes.Buffer{}
+	if err = h.printHist(have, data); err != nil {
+		t.Fatalf("h.printHist returned unexpected error=%v", err)
+	}
+	want := strings.Join([]string{
+		"              1 naïve          ++++++++++++++",
+		"              1 😀😀😀😀😀😀…  ++++++++++++++",
+		"              2 東京           ++++++++++++++++++++++++++++",
+		""}, "\n")
+	if have.String() != want {
+		t.Errorf("hist(wide keys) printed bad results.\nhave=%q\nwant=%q", have.String(), want)
+	}
+}
+
+func TestKeyWidth(t *testing.T) {
+	const in = `/usr/local/lib/go/src/file.go
+/usr/local/lib/go/src/file.go
+/b`
+	for _, d := range []struct {
+		kwidth int
+		snipf  func(string, int) (string, bool)
+		want   []string
+	}{
+		{
+			kwidth: keyAuto,
+			want: []string{
+				"              1 /b                            +++++++",
+				"              2 /usr/local/lib/go/src/file.go +++++++++++++",
+			},
+		},
+		{
+			kwidth: 16,
+			snipf:  internal.SnipPath,
+			want: []string{
+				"              1 /b               +++++++++++++",
+				"              2 /…/src/file.go   ++++++++++++++++++++++++++",
+			},
+		},
+		{
+			kwidth: 12,
+			snipf:  internal.SnipTail,
+			want: []string{
+				"              1 /b           +++++++++++++++",
+				"              2 …src/file.go ++++++++++++++++++++++++++++++",
+			},
+		},
+	} {
+		h := &histogrammer{
+			ifs:       regexp.MustCompile(" +"),
+			termWidth: 60,
+			gt:        gLinear,
+			snip:      true,
+			snipf:     d.snipf,
+			kwidth:    d.kwidth,
+		}
+		data, err := h.hist(bytes.NewBufferString(in))
+		if err != nil {
+			t.Fatalf("h.hist returned unexpected error=%v", err)
+		}
+		have := &bytes.Buffer{}
+		if err = h.printHist(have, data); err != nil {
+			t.Fatalf("h.printHist returned unexpected error=%v", err)
+		}
+		if want := strings.Join(d.want, "\n") + "\n"; have.String() != want {
+			t.Errorf("hist(kwidth=%d) printed bad results.\nhave=%q\nwant=%q", d.kwidth, have.String(), want)
+		}
+	}
+}
+
+func TestGraphPositive(t *testing.T) {
+	// Without negative values, the graph does not diverge; log scale graphs
+	// zero and one.
+	const in = `z 0
+a 1
+b 10
+c 100`
+	for _, d := range []struct {
+		scale gType
+		want  []string
+	}{
+		{gLinear, []string{
+			"              0 z",
+			"              1 a",
+			"             10 b    ++",
+			"            100 c    ++++++++++++++++++",
+		}},
+		{gLog, []string{
+			"              0 z",
+			"              1 a    +++",
+			"             10 b    +++++++++",
+			"            100 c    ++++++++++++++++++",
+		}},
+	} {
+		h := &histogrammer{
+			keys:      []int{1},
+			weightCol: 2,
+			ifs:       regexp.MustCompile(" +"),
+			termWidth: 40,
+			gt:        d.scale,
+		}
+		data, err := h.hist(bytes.NewBufferString(in))
+		if err != nil {
+			t.Fatalf("h.hist returned unexpected error=%v", err)
+		}
+		have := &bytes.Buffer{}
+		if err = h.printHist(have, data); err != nil {
+			t.Fatalf("h.printHist returned unexpected error=%v", err)
+		}
+		if want := strings.Join(d.want, "\n") + "\n"; have.String() != want {
+			t.Errorf("hist (scale=%v) returned bad results.\nhave=%q\nwant=%q", d.scale, have.String(), want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// Counter counts distinct values.
+type Counter interface {
+	Add(v []byte)
+	Count() uint64
+}
+
+// ExactCounter counts distinct values exactly, keeping a copy of each.
+type ExactCounter map[string]struct{}
+
+// Add adds v to the set of values seen.
+func (c ExactCounter) Add(v []byte) { c[string(v)] = struct{}{} }
+
+// Count returns the number of distinct values seen.
+func (c ExactCounter) Count() uint64 { return uint64(len(c)) }
+
+// HyperLogLog estimates the number of distinct values in fixed memory: 2^p
+// bytes for precision p, with a standard error of about 1.04/sqrt(2^p).
+type HyperLogLog struct {
+	p    uint
+	regs []uint8
+}
+
+// NewHyperLogLog returns an empty HyperLogLog of precision p, between 4 and
+// 18.
+func NewHyperLogLog(p int) (*HyperLogLog, error) {
+	if p < 4 || p > 18 {
+		return nil, fmt.Errorf("bad HyperLogLog precision %d: must be between 4 and 18", p)
+	}
+	return &HyperLogLog{p: uint(p), regs: make([]uint8, 1<<uint(p))}, nil
+}
+
+// hash64 is 64-bit FNV-1a, finalized with the MurmurHash3 mixer: FNV's
+// high bits are too poorly distributed to use directly.
+func hash64(v []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(v)
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// Add adds v to the values seen.
+func (h *HyperLogLog) Add(v []byte) {
+	x := hash64(v)
+	i := x >> (64 - h.p)
+	rank := uint8(bits.LeadingZeros64(x<<h.p|1<<(h.p-1)) + 1)
+	if rank > h.regs[i] {
+		h.regs[i] = rank
+	}
+}
+
+// Count returns the estimated number of distinct values seen.
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.regs))
+	var sum float64
+	var zeros int
+	for _, r := range h.regs {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	var alpha float64
+	switch len(h.regs) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	e := alpha * m * m / sum
+	if e <= 2.5*m && zeros > 0 { // small range: linear counting is better.
+		e = m * math.Log(m/float64(zeros))
+	}
+	return uint64(e + 0.5)
+}
@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestExactCounter(t *testing.T) {
+	c := ExactCounter{}
+	for _, v := range []string{"a", "b", "a", "", "c", "b"} {
+		c.Add([]byte(v))
+	}
+	if have, want := c.Count(), uint64(4); have != want {
+		t.Errorf("ExactCounter.Count()=%d, want=%d", have, want)
+	}
+}
+
+func TestHyperLogLog(t *testing.T) {
+	for _, d := range []struct {
+		p, n int
+		tol  float64 // relative.
+	}{
+		{14, 0, 0},
+		{14, 10, 0},
+		{14, 1000, 0.01},
+		{14, 200000, 0.03},
+		{10, 200000, 0.1},
+	} {
+		h, err := NewHyperLogLog(d.p)
+		if err != nil {
+			t.Fatalf("NewHyperLogLog(%d) returned unexpected error=%v", d.p, err)
+		}
+		for i := 0; i < d.n; i++ {
+			v := []byte("user" + strconv.Itoa(i))
+			h.Add(v)
+			h.Add(v) // duplicates don't count.
+		}
+		have := float64(h.Count())
+		if math.Abs(have-float64(d.n)) > d.tol*float64(d.n) {
+			t.Errorf("HyperLogLog(p=%d) counted %v of %d distinct values, want within %v%%", d.p, have, d.n, d.tol*100)
+		}
+	}
+	for _, p := range []int{3, 19} {
+		if _, err := NewHyperLogLog(p); err == nil {
+			t.Errorf("NewHyperLogLog(%d) returned no error", p)
+		}
+	}
+}
@@ -0,0 +1,604 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Expr is an expression computing a value from the fields of a line, in the
+// style of awk. For example:
+//
+//   $5 / 1024
+//   sprintf("%.2f%%", 100 * $2 / $3)
+//   lower(substr($1, 1, 3))
+//   $3 >= 400 and $1 ~ /^GET/
+//
+// Fields are written as in a Parter spec: $1 is the first field, $-1 the last.
+// Fields may also be named, by a header line or by named regexp capture
+// groups; see SetNames. Values are strings, which are treated as numbers by
+// arithmetic. Comparisons are numeric if both sides are numbers, and lexical
+// otherwise. The operators ~ and !~ match a regexp. Logical operators are
+// and, or, not (or &&, ||, !) and yield 1 or 0. A value is false if it is
+// empty or zero.
+//
+// Functions are:
+//
+//   length(s)          length of s in characters
+//   lower(s), upper(s) s in lower or upper case
+//   substr(s, i[, n])  n characters of s, starting from the i-th (1-based)
+//   int(x)             x truncated to an integer
+//   round(x[, d])      x rounded to d decimal places
+//   human(x)           x as a human-readable byte size, such as 1.5K or 12M
+//   sprintf(f, ...)    its arguments, formatted per f as in Go's fmt
+type Expr struct {
+	src  string
+	root node
+	cols []*fieldNode // field references, for resolving names.
+}
+
+// NewExpr parses s into an Expr.
+func NewExpr(s string) (*Expr, error) {
+	ps := &parser{}
+	if err := ps.lex(s); err != nil {
+		return nil, err
+	}
+	if len(ps.toks) == 0 {
+		return nil, fmt.Errorf("expression: empty expression")
+	}
+	root, err := ps.or()
+	if err != nil {
+		return nil, err
+	}
+	if ps.pos < len(ps.toks) {
+		return nil, fmt.Errorf("expression: unexpected %q", ps.toks[ps.pos].s)
+	}
+	return &Expr{src: s, root: root, cols: ps.cols}, nil
+}
+
+// String returns the source of e.
+func (e *Expr) String() string { return e.src }
+
+// Field reports whether e is a reference to a single field, and if so which.
+func (e *Expr) Field() (int, bool) {
+	if f, ok := e.root.(*fieldNode); ok && f.col != 0 {
+		return f.col, true
+	}
+	return 0, false
+}
+
+// SetNames resolves named fields in the expression. names[0] is the name of
+// the first field. It is an error for the expression to refer to a name not
+// in names.
+func (e *Expr) SetNames(names []string) error {
+	for _, c := range e.cols {
+		if c.name == "" {
+			continue
+		}
+		c.col = 0
+		for i, n := range names {
+			if n == c.name {
+				c.col = i + 1
+				break
+			}
+		}
+		if c.col == 0 {
+			return fmt.Errorf("expression: unknown field $%s", c.name)
+		}
+	}
+	return nil
+}
+
+// Eval evaluates e over fields.
+func (e *Expr) Eval(fields [][]byte) string { return e.root.eval(fields).s }
+
+// Truth evaluates e over fields as a condition.
+func (e *Expr) Truth(fields [][]byte) bool { return e.root.eval(fields).truth() }
+
+// val is the value of an expression.
+type val struct {
+	s   string
+	n   float64
+	num bool // s looks like a number, whose value is n.
+}
+
+func strVal(s string) val {
+	t := strings.TrimSpace(s)
+	if t == "" || !strings.ContainsAny(t[:1], "0123456789.+-") {
+		return val{s: s}
+	}
+	n, err := strconv.ParseFloat(t, 64)
+	return val{s: s, n: n, num: err == nil}
+}
+
+func numVal(n float64) val { return val{s: FormatNumber(n), n: n, num: true} }
+
+func boolVal(b bool) val {
+	if b {
+		return numVal(1)
+	}
+	return numVal(0)
+}
+
+func (v val) truth() bool { return v.s != "" && !(v.num && v.n == 0) }
+
+type node interface {
+	eval(fields [][]byte) val
+}
+
+type litNode struct{ v val }
+
+func (n litNode) eval([][]byte) val { return n.v }
+
+type fieldNode struct {
+	col  int    // 1-based field index. Negative values count from the end.
+	name string // field name, if the field is named.
+}
+
+func (n *fieldNode) eval(fields [][]byte) val {
+	i := n.col
+	if i < 0 {
+		i += len(fields)
+	} else {
+		i--
+	}
+	if i < 0 || i >= len(fields) {
+		return val{}
+	}
+	return strVal(string(fields[i]))
+}
+
+type binNode struct {
+	op   string
+	l, r node
+}
+
+func (n binNode) eval(fields [][]byte) val {
+	l := n.l.eval(fields)
+	switch n.op {
+	case "&&":
+		return boolVal(l.truth() && n.r.eval(fields).truth())
+	case "||":
+		return boolVal(l.truth() || n.r.eval(fields).truth())
+	}
+	r := n.r.eval(fields)
+	switch n.op {
+	case "+":
+		return numVal(l.n + r.n)
+	case "-":
+		return numVal(l.n - r.n)
+	case "*":
+		return numVal(l.n * r.n)
+	case "/":
+		return numVal(l.n / r.n)
+	case "%":
+		return numVal(math.Mod(l.n, r.n))
+	}
+	var c int
+	if l.num && r.num {
+		switch {
+		case l.n < r.n:
+			c = -1
+		case l.n > r.n:
+			c = 1
+		}
+	} else {
+		c = strings.Compare(l.s, r.s)
+	}
+	switch n.op {
+	case "==":
+		return boolVal(c == 0)
+	case "!=":
+		return boolVal(c != 0)
+	case "<":
+		return boolVal(c < 0)
+	case "<=":
+		return boolVal(c <= 0)
+	case ">":
+		return boolVal(c > 0)
+	case ">=":
+		return boolVal(c >= 0)
+	}
+	panic("unknown operator " + n.op)
+}
+
+type unNode struct {
+	op string
+	n  node
+}
+
+func (n unNode) eval(fields [][]byte) val {
+	v := n.n.eval(fields)
+	switch n.op {
+	case "!":
+		return boolVal(!v.truth())
+	case "-":
+		return numVal(-v.n)
+	}
+	return numVal(v.n)
+}
+
+type matchNode struct {
+	n   node
+	re  *regexp.Regexp
+	neg bool
+}
+
+func (n matchNode) eval(fields [][]byte) val {
+	return boolVal(n.re.MatchString(n.n.eval(fields).s) != n.neg)
+}
+
+type callNode struct {
+	f    func(args []val) val
+	args []node
+}
+
+func (n callNode) eval(fields [][]byte) val {
+	args := make([]val, len(n.args))
+	for i, a := range n.args {
+		args[i] = a.eval(fields)
+	}
+	return n.f(args)
+}
+
+type function struct {
+	min, max int // number of arguments; max < 0 for variadic functions.
+	f        func(args []val) val
+}
+
+var functions = map[string]function{
+	"length": {1, 1, func(a []val) val { return numVal(float64(utf8.RuneCountInString(a[0].s))) }},
+	"lower":  {1, 1, func(a []val) val { return val{s: strings.ToLower(a[0].s)} }},
+	"upper":  {1, 1, func(a []val) val { return val{s: strings.ToUpper(a[0].s)} }},
+	"substr": {2, 3, substr},
+	"int":    {1, 1, func(a []val) val { return numVal(math.Trunc(a[0].n)) }},
+	"round": {1, 2, func(a []val) val {
+		p := 1.0
+		if len(a) > 1 {
+			p = math.Pow(10, math.Trunc(a[1].n))
+		}
+		return numVal(math.Round(a[0].n*p) / p)
+	}},
+	"human":   {1, 1, func(a []val) val { return val{s: FormatBytes(a[0].n)} }},
+	"sprintf": {1, -1, sprintf},
+}
+
+func substr(a []val) val {
+	r := []rune(a[0].s)
+	beg := int(math.Round(a[1].n)) - 1
+	end := len(r)
+	if len(a) > 2 {
+		end = beg + int(math.Round(a[2].n))
+	}
+	if beg < 0 {
+		beg = 0
+	}
+	if end > len(r) {
+		end = len(r)
+	}
+	if beg >= end {
+		return val{}
+	}
+	return val{s: string(r[beg:end])}
+}
+
+// sprintf formats its arguments per the format in the first one. Arguments
+// are converted to suit the verbs that consume them.
+func sprintf(a []val) val {
+	f := a[0].s
+	var args []interface{}
+	i := 1
+	for j := 0; j < len(f); j++ {
+		if f[j] != '%' {
+			continue
+		}
+		j++
+		for j < len(f) && strings.IndexByte("+-# 0123456789.", f[j]) >= 0 {
+			j++
+		}
+		if j == len(f) || f[j] == '%' {
+			continue
+		}
+		if i >= len(a) {
+			break
+		}
+		switch f[j] {
+		case 'd', 'o', 'x', 'X', 'c', 'b':
+			args = append(args, int64(a[i].n))
+		case 'e', 'E', 'f', 'F', 'g', 'G':
+			args = append(args, a[i].n)
+		default:
+			args = append(args, a[i].s)
+		}
+		i++
+	}
+	return val{s: fmt.Sprintf(f, args...)}
+}
+
+type tokType int
+
+const (
+	tokOp    tokType = iota // operators, parentheses and commas.
+	tokField                // $N or $name; s holds what follows the $.
+	tokNum                  // numbers.
+	tokStr                  // quoted strings; s holds the value.
+	tokWord                 // bare words: function names, or else strings.
+	tokRE                   // /regexp/; s holds the regexp.
+)
+
+type token struct {
+	t tokType
+	s string
+}
+
+type parser struct {
+	toks []token
+	pos  int
+	cols []*fieldNode
+}
+
+var (
+	exprOps   = []string{"==", "!=", "<=", ">=", "!~", "&&", "||", "<", ">", "~", "!", "(", ")", ",", "+", "-", "*", "%"}
+	exprWords = map[string]string{"and": "&&", "or": "||", "not": "!"}
+	numRE     = regexp.MustCompile(`^(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?`)
+)
+
+func (ps *parser) lex(s string) error {
+	isWord := func(r rune) bool { return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r) }
+	word := func(s string) string {
+		i := strings.IndexFunc(s, func(r rune) bool { return !isWord(r) })
+		if i < 0 {
+			return s
+		}
+		return s[:i]
+	}
+	// afterOperand reports whether the last token ends an operand, in which
+	// case a slash is a division rather than the start of a regexp.
+	afterOperand := func() bool {
+		if len(ps.toks) == 0 {
+			return false
+		}
+		t := ps.toks[len(ps.toks)-1]
+		return t.t != tokOp && t.t != tokRE || t.s == ")"
+	}
+lexing:
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		for _, op := range exprOps {
+			if strings.HasPrefix(s, op) {
+				ps.toks = append(ps.toks, token{tokOp, op})
+				s = s[len(op):]
+				continue lexing
+			}
+		}
+		if m := numRE.FindString(s); m != "" {
+			ps.toks = append(ps.toks, token{tokNum, m})
+			s = s[len(m):]
+			continue
+		}
+		switch c := s[0]; {
+		case c == '/' && afterOperand():
+			ps.toks = append(ps.toks, token{tokOp, "/"})
+			s = s[1:]
+		case c == '$':
+			w := word(s[1:])
+			if strings.HasPrefix(s, "$-") {
+				w = "-" + word(s[2:])
+			}
+			if w == "" || w == "-" {
+				return fmt.Errorf("expression: bad field reference in %q", s)
+			}
+			ps.toks = append(ps.toks, token{tokField, w})
+			s = s[1+len(w):]
+		case c == '"' || c == '\'' || c == '/':
+			var b bytes.Buffer
+			i := 1
+			for ; i < len(s) && s[i] != c; i++ {
+				if s[i] == '\\' && i+1 < len(s) && s[i+1] == c {
+					i++
+				}
+				b.WriteByte(s[i])
+			}
+			if i == len(s) {
+				return fmt.Errorf("expression: unterminated %c in %q", c, s)
+			}
+			t := tokStr
+			if c == '/' {
+				t = tokRE
+			}
+			ps.toks = append(ps.toks, token{t, b.String()})
+			s = s[i+1:]
+		default:
+			w := word(s)
+			if w == "" {
+				return fmt.Errorf("expression: unexpected %q", s)
+			}
+			if op, ok := exprWords[w]; ok {
+				ps.toks = append(ps.toks, token{tokOp, op})
+			} else {
+				ps.toks = append(ps.toks, token{tokWord, w})
+			}
+			s = s[len(w):]
+		}
+	}
+	return nil
+}
+
+// peek reports whether the next token is one of the operators in ops, and if
+// so returns it.
+func (ps *parser) peek(ops ...string) (string, bool) {
+	if ps.pos >= len(ps.toks) || ps.toks[ps.pos].t != tokOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if ps.toks[ps.pos].s == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (ps *parser) next() (token, error) {
+	if ps.pos >= len(ps.toks) {
+		return token{}, fmt.Errorf("expression: unexpected end of expression")
+	}
+	ps.pos++
+	return ps.toks[ps.pos-1], nil
+}
+
+// binary parses a left-associative sequence of operands produced by sub,
+// separated by any of ops.
+func (ps *parser) binary(sub func() (node, error), ops ...string) (node, error) {
+	l, err := sub()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := ps.peek(ops...)
+		if !ok {
+			return l, nil
+		}
+		ps.pos++
+		r, err := sub()
+		if err != nil {
+			return nil, err
+		}
+		l = binNode{op, l, r}
+	}
+}
+
+func (ps *parser) or() (node, error)  { return ps.binary(ps.and, "||") }
+func (ps *parser) and() (node, error) { return ps.binary(ps.not, "&&") }
+func (ps *parser) add() (node, error) { return ps.binary(ps.mul, "+", "-") }
+func (ps *parser) mul() (node, error) { return ps.binary(ps.unary, "*", "/", "%") }
+
+func (ps *parser) not() (node, error) {
+	if _, ok := ps.peek("!"); ok {
+		ps.pos++
+		n, err := ps.not()
+		if err != nil {
+			return nil, err
+		}
+		return unNode{"!", n}, nil
+	}
+	return ps.cmp()
+}
+
+func (ps *parser) cmp() (node, error) {
+	l, err := ps.add()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := ps.peek("==", "!=", "<=", ">=", "<", ">", "~", "!~")
+	if !ok {
+		return l, nil
+	}
+	ps.pos++
+	if op == "~" || op == "!~" {
+		t, err := ps.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.t != tokRE && t.t != tokStr {
+			return nil, fmt.Errorf("expression: %s wants a regexp, got %q", op, t.s)
+		}
+		re, err := regexp.Compile(t.s)
+		if err != nil {
+			return nil, fmt.Errorf("expression: %v", err)
+		}
+		return matchNode{l, re, op == "!~"}, nil
+	}
+	r, err := ps.add()
+	if err != nil {
+		return nil, err
+	}
+	return binNode{op, l, r}, nil
+}
+
+func (ps *parser) unary() (node, error) {
+	if op, ok := ps.peek("-", "+"); ok {
+		ps.pos++
+		n, err := ps.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unNode{op, n}, nil
+	}
+	return ps.primary()
+}
+
+func (ps *parser) primary() (node, error) {
+	t, err := ps.next()
+	if err != nil {
+		return nil, err
+	}
+	switch t.t {
+	case tokOp:
+		if t.s != "(" {
+			break
+		}
+		n, err := ps.or()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ps.peek(")"); !ok {
+			return nil, fmt.Errorf("expression: missing )")
+		}
+		ps.pos++
+		return n, nil
+	case tokNum, tokStr:
+		return litNode{strVal(t.s)}, nil
+	case tokWord:
+		if _, ok := ps.peek("("); ok {
+			return ps.call(t.s)
+		}
+		return litNode{strVal(t.s)}, nil
+	case tokField:
+		f := &fieldNode{}
+		if n, err := strconv.Atoi(t.s); err == nil {
+			if n == 0 {
+				n = 1
+			}
+			f.col = n
+		} else {
+			f.name = t.s
+		}
+		ps.cols = append(ps.cols, f)
+		return f, nil
+	}
+	return nil, fmt.Errorf("expression: unexpected %q", t.s)
+}
+
+func (ps *parser) call(name string) (node, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, fmt.Errorf("expression: unknown function %s", name)
+	}
+	ps.pos++ // (
+	var args []node
+	if _, ok := ps.peek(")"); !ok {
+		for {
+			a, err := ps.or()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, a)
+			if _, ok := ps.peek(","); !ok {
+				break
+			}
+			ps.pos++
+		}
+	}
+	if _, ok := ps.peek(")"); !ok {
+		return nil, fmt.Errorf("expression: missing ) in call to %s", name)
+	}
+	ps.pos++
+	if len(args) < fn.min || fn.max >= 0 && len(args) > fn.max {
+		return nil, fmt.Errorf("expression: wrong number of arguments to %s", name)
+	}
+	return callNode{fn.f, args}, nil
+}
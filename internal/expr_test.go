@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestExpr(t *testing.T) {
+	fields := [][]byte{[]byte("GET"), []byte("/Index.html"), []byte("200"), []byte("1536"), []byte("3")}
+	for _, d := range []struct {
+		expr, want string
+	}{
+		{`$4 / 1024`, "1.5"},
+		{`$4/1e6`, "0.001536"},
+		{`$4 - $5 * 2`, "1530"},
+		{`($4 - $5) * 2`, "3066"},
+		{`-$5 + 1`, "-2"},
+		{`$4 % 1000`, "536"},
+		{`$-1`, "3"},
+		{`$0`, "GET"},
+		{`$9`, ""},
+		{`$1 / 2`, "0"},
+		{`lower($2)`, "/index.html"},
+		{`upper(substr($2, 2, 5))`, "INDEX"},
+		{`substr($2, 7)`, ".html"},
+		{`length($2)`, "11"},
+		{`int($4 / 1000)`, "1"},
+		{`round(2 / 3, 2)`, "0.67"},
+		{`human($4)`, "1.5K"},
+		{`human($4 * 1024 * 20)`, "30M"},
+		{`human(100)`, "100"},
+		{`sprintf("%.2f|%5d|%s", $4 / 7, $3, $1)`, "219.43|  200|GET"},
+		{`sprintf("%d%%", 100 * $5 / 4)`, "75%"},
+		{`$3 == 200 && $1 ~ /^G/`, "1"},
+		{`$3 > 1000`, "0"},
+	} {
+		e, err := NewExpr(d.expr)
+		if err != nil {
+			t.Errorf("NewExpr(%q) returned unexpected error=%v", d.expr, err)
+			continue
+		}
+		if have := e.Eval(fields); have != d.want {
+			t.Errorf("NewExpr(%q).Eval()=%q, want=%q", d.expr, have, d.want)
+		}
+	}
+}
+
+func TestExprErrors(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`$1 +`,
+		`nosuch($1)`,
+		`lower($1, $2)`,
+		`substr($1)`,
+		`sprintf("%d", $1`,
+	} {
+		if _, err := NewExpr(expr); err == nil {
+			t.Errorf("NewExpr(%q) returned no error", expr)
+		}
+	}
+}
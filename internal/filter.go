@@ -0,0 +1,71 @@
+package internal
+
+// Filter is a predicate on input lines, given as an Expr on their fields.
+// For example:
+//
+//   $3 > 1000
+//   $1 ~ /^GET/ and not ($2 == "/" or $2 == "/favicon.ico")
+//   $status == 500 || $status == 503
+type Filter struct {
+	p *Parter
+	e *Expr
+}
+
+// NewFilter parses expr into a Filter over the fields p produces. p should
+// return all fields, as Parter.All does.
+func NewFilter(expr string, p *Parter) (*Filter, error) {
+	e, err := NewExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{p: p, e: e}, nil
+}
+
+// ParseFilter is like NewFilter, but returns a nil Filter for an empty
+// expression. Unless header is set, field names are resolved from p.Names;
+// otherwise callers should pass the header line to SetHeader.
+func ParseFilter(expr string, p *Parter, header bool) (*Filter, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	f, err := NewFilter(expr, p.All())
+	if err != nil {
+		return nil, err
+	}
+	if !header {
+		if err := f.SetNames(p.Names()); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// SetNames resolves named fields in the filter. See Expr.SetNames.
+func (f *Filter) SetNames(names []string) error { return f.e.SetNames(names) }
+
+// SetHeader resolves named fields in the filter from a header line. It does
+// nothing on a nil Filter.
+func (f *Filter) SetHeader(line []byte) error {
+	if f == nil {
+		return nil
+	}
+	return f.SetNames(HeaderNames(f.p, line))
+}
+
+// Match reports whether line satisfies the filter. A nil Filter matches all
+// lines.
+func (f *Filter) Match(line []byte) bool {
+	if f == nil {
+		return true
+	}
+	return f.e.Truth(f.p.Fields(line))
+}
+
+// HeaderNames returns the field names given by a header line, as parted by p.
+func HeaderNames(p *Parter, line []byte) []string {
+	var names []string
+	for _, v := range p.All().Fields(line) {
+		names = append(names, string(v))
+	}
+	return names
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	p := NewParter(regexp.MustCompile(" +"), nil)
+	lines := []string{
+		"GET /index.html 200 1500",
+		"GET /favicon.ico 404 0",
+		"POST /login 500 20",
+		"HEAD / 200",
+	}
+	for _, d := range []struct {
+		expr string
+		want []bool
+	}{
+		{`$3 == 200`, []bool{true, false, false, true}},
+		{`$4 > 1000`, []bool{true, false, false, false}},
+		{`$4 > 5`, []bool{true, false, true, false}}, // numeric, not lexical.
+		{`$1 ~ /^(GET|HEAD)$/`, []bool{true, true, false, true}},
+		{`$2 !~ "\.ico$"`, []bool{true, false, true, true}},
+		{`$1 == GET and $3 != 200`, []bool{false, true, false, false}},
+		{`$3 >= 400 || $-1 == 200`, []bool{false, true, true, true}},
+		{`not ($1 == GET or $1 == POST)`, []bool{false, false, false, true}},
+		{`!$4`, []bool{false, true, false, true}},
+		{`$2 < "/f"`, []bool{false, false, false, true}},
+	} {
+		f, err := NewFilter(d.expr, p)
+		if err != nil {
+			t.Errorf("NewFilter(%q) returned unexpected error=%v", d.expr, err)
+			continue
+		}
+		for i, l := range lines {
+			if have := f.Match([]byte(l)); have != d.want[i] {
+				t.Errorf("NewFilter(%q).Match(%q)=%v, want=%v", d.expr, l, have, d.want[i])
+			}
+		}
+	}
+}
+
+func TestFilterNames(t *testing.T) {
+	p := NewParter(regexp.MustCompile(" +"), []int{2})
+	f, err := ParseFilter(`$status == 500`, p, true)
+	if err != nil {
+		t.Fatalf("ParseFilter returned unexpected error=%v", err)
+	}
+	if err := f.SetHeader([]byte("method status")); err != nil {
+		t.Fatalf("SetHeader returned unexpected error=%v", err)
+	}
+	if !f.Match([]byte("GET 500")) || f.Match([]byte("GET 200")) {
+		t.Errorf("filter on named field matched wrong lines")
+	}
+	if err := f.SetHeader([]byte("method code")); err == nil {
+		t.Errorf("SetHeader with missing name returned no error")
+	}
+
+	re := regexp.MustCompile(`(?P<method>\w+) (?P<status>\d+)`)
+	if _, err := ParseFilter(`$status == 500`, NewMatchParter(re, nil), false); err != nil {
+		t.Errorf("ParseFilter with capture group names returned unexpected error=%v", err)
+	}
+	if _, err := ParseFilter(`$status == 500`, p, false); err == nil {
+		t.Errorf("ParseFilter with unknown name returned no error")
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	p := NewParter(regexp.MustCompile(" +"), nil)
+	for _, expr := range []string{
+		`$1 ==`,
+		`($1 == 2`,
+		`$1 == 2)`,
+		`$1 ~ "("`,
+		`$ == 1`,
+		`$1 == "abc`,
+		`$1 $2`,
+	} {
+		if _, err := NewFilter(expr, p); err == nil {
+			t.Errorf("NewFilter(%q) returned no error", expr)
+		}
+	}
+}
@@ -22,6 +22,24 @@ func AtoiList(nums []string) ([]int, error) {
 	return res, nil
 }
 
+// SubexpList is like AtoiList, but also accepts the names of capture groups
+// in re.
+func SubexpList(re *regexp.Regexp, keys []string) ([]int, error) {
+	var res []int
+	for _, v := range keys {
+		if i := re.SubexpIndex(v); i > 0 {
+			res = append(res, i)
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("not an integer or group name: %q", v)
+		}
+		res = append(res, n)
+	}
+	return res, nil
+}
+
 // splitb is very similar to regexp.Split(s, -1) but returns [][]byte.
 func splitb(re *regexp.Regexp, b []byte) [][]byte {
 	matches := re.FindAllIndex(b, -1)
@@ -45,8 +63,9 @@ func splitb(re *regexp.Regexp, b []byte) [][]byte {
 // parts of the split using 1-based indexing. Negative values are allowed and
 // count from the input end.
 type Parter struct {
-	re  *regexp.Regexp
-	idx []int
+	re    *regexp.Regexp
+	idx   []int
+	match bool
 }
 
 // NewParter creates a new Parter using ifs and the given index list.
@@ -54,9 +73,29 @@ func NewParter(ifs *regexp.Regexp, idx []int) *Parter {
 	return &Parter{re: ifs, idx: append([]int(nil), idx...)}
 }
 
-// Fields returns the fields in line matching the Parter spec.
+// NewMatchParter creates a new Parter whose fields are the capture groups of
+// the first match of re in each line, rather than the parts between matches.
+// If re has no capture groups, the entire match is the only field.
+func NewMatchParter(re *regexp.Regexp, idx []int) *Parter {
+	return &Parter{re: re, idx: append([]int(nil), idx...), match: true}
+}
+
+// Fields returns the fields in line matching the Parter spec. For Parters
+// created with NewMatchParter, it returns nil if line does not match.
 func (p Parter) Fields(line []byte) [][]byte {
-	parts := splitb(p.re, line)
+	var parts [][]byte
+	if p.match {
+		m := p.re.FindSubmatch(line)
+		if m == nil {
+			return nil
+		}
+		parts = m[1:]
+		if len(parts) == 0 {
+			parts = m
+		}
+	} else {
+		parts = splitb(p.re, line)
+	}
 	// No idx means all fields (simple way to change delim / normalize its width)
 	if len(p.idx) == 0 {
 		return parts
@@ -78,6 +117,21 @@ func (p Parter) Fields(line []byte) [][]byte {
 	return out
 }
 
+// All returns a Parter like p that returns all fields.
+func (p Parter) All() *Parter {
+	return &Parter{re: p.re, match: p.match}
+}
+
+// Names returns the names of the fields p splits lines into, as far as they
+// are known: those of named capture groups, for Parters created with
+// NewMatchParter. Unnamed fields have empty names.
+func (p Parter) Names() []string {
+	if !p.match || p.re.NumSubexp() == 0 {
+		return nil
+	}
+	return p.re.SubexpNames()[1:]
+}
+
 // SetUsage updates flag.Usage with help text. It should be called before flag.Parse.
 //
 // TODO: our convention is to repeat the package doc string here. Is there a way to
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestParterFields(t *testing.T) {
+	for _, d := range []struct {
+		p    *Parter
+		line string
+		want []string
+	}{
+		{NewParter(regexp.MustCompile(" +"), nil), "a b  c", []string{"a", "b", "c"}},
+		{NewParter(regexp.MustCompile(" +"), []int{3, -3, 4}), "a b  c", []string{"c", "a", ""}},
+		{NewMatchParter(regexp.MustCompile(`(\w+)@(\w+)`), nil), "to: joe@example", []string{"joe", "example"}},
+		{NewMatchParter(regexp.MustCompile(`(\w+)@(\w+)`), []int{-1}), "to: joe@example", []string{"example"}},
+		{NewMatchParter(regexp.MustCompile(`\d+`), nil), "abc 123 456", []string{"123"}},
+		{NewMatchParter(regexp.MustCompile(`\d+`), []int{1}), "abc", nil},
+	} {
+		var have []string
+		for _, f := range d.p.Fields([]byte(d.line)) {
+			have = append(have, string(f))
+		}
+		if !reflect.DeepEqual(have, d.want) {
+			t.Errorf("Fields(%q)=%q, want=%q", d.line, have, d.want)
+		}
+	}
+}
+
+func TestSubexpList(t *testing.T) {
+	re := regexp.MustCompile(`(?P<user>\w+)@(?P<host>\w+)`)
+	have, err := SubexpList(re, []string{"host", "1", "-1"})
+	if err != nil {
+		t.Fatalf("SubexpList returned unexpected error=%v", err)
+	}
+	if want := []int{2, 1, -1}; !reflect.DeepEqual(have, want) {
+		t.Errorf("SubexpList=%v, want=%v", have, want)
+	}
+	if _, err := SubexpList(re, []string{"domain"}); err == nil {
+		t.Errorf("SubexpList(domain) returned no error")
+	}
+}
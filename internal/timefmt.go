@@ -0,0 +1,202 @@
+package internal
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp layouts known by name to TimeParser.
+const (
+	CLFLayout    = "02/Jan/2006:15:04:05 -0700"
+	SyslogLayout = time.Stamp
+)
+
+// autoLayouts are tried in order by TimeParser("auto").
+var autoLayouts = []string{
+	time.RFC3339Nano,
+	CLFLayout,
+	SyslogLayout,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.UnixDate,
+}
+
+// TimeParser returns a function that parses timestamps in the given format:
+//
+//   auto     any of the below, or a few other common layouts, per timestamp
+//   rfc3339  2006-01-02T15:04:05Z07:00, optionally with fractional seconds
+//   unix     seconds since the Unix epoch, optionally fractional
+//   unixms   milliseconds since the Unix epoch
+//   clf      02/Jan/2006:15:04:05 -0700, as in web server logs
+//   syslog   Jan _2 15:04:05, taken to be in the current year
+//
+// Any other format is taken to be a Go time layout. Surrounding brackets are
+// ignored, as CLF timestamps usually have them. Timestamps without a time zone
+// are in local time, except Unix times, which are in UTC.
+func TimeParser(format string) func(string) (time.Time, error) {
+	layout := func(l string) func(string) (time.Time, error) {
+		return func(s string) (time.Time, error) {
+			return parseLayout(l, strings.Trim(s, "[]"))
+		}
+	}
+	switch format {
+	case "auto":
+		return func(s string) (time.Time, error) {
+			s = strings.Trim(s, "[]")
+			if t, err := parseUnix(s, time.Second); err == nil {
+				if t.Year() > 5000 { // too far out: must be milliseconds.
+					return parseUnix(s, time.Millisecond)
+				}
+				return t, nil
+			}
+			for _, l := range autoLayouts {
+				if t, err := parseLayout(l, s); err == nil {
+					return t, nil
+				}
+			}
+			return time.Time{}, fmt.Errorf("unknown time format: %q", s)
+		}
+	case "rfc3339":
+		return layout(time.RFC3339Nano)
+	case "unix":
+		return func(s string) (time.Time, error) { return parseUnix(s, time.Second) }
+	case "unixms":
+		return func(s string) (time.Time, error) { return parseUnix(s, time.Millisecond) }
+	case "clf":
+		return layout(CLFLayout)
+	case "syslog":
+		return layout(SyslogLayout)
+	}
+	return layout(format)
+}
+
+func parseLayout(layout, s string) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, s, time.Local)
+	if err != nil {
+		return t, err
+	}
+	if t.Year() == 0 { // layout has no year, as in syslog.
+		t = t.AddDate(time.Now().Year(), 0, 0)
+	}
+	return t, nil
+}
+
+func parseUnix(s string, unit time.Duration) (time.Time, error) {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return time.Time{}, fmt.Errorf("bad Unix time: %q", s)
+	}
+	sec, frac := math.Modf(n * float64(unit) / float64(time.Second))
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+}
+
+// dayRE matches durations starting with a number of days, such as "1d" or
+// "1.5d" or "1d12h".
+var dayRE = regexp.MustCompile(`^([-+]?)([0-9]+(?:\.[0-9]*)?|\.[0-9]+)d([0-9.].*)?$`)
+
+// ParseDuration parses a duration as time.ParseDuration does, also
+// accepting a leading number of days of 24 hours each, as in "1d" or "2d12h".
+func ParseDuration(s string) (time.Duration, error) {
+	m := dayRE.FindStringSubmatch(s)
+	if m == nil {
+		return time.ParseDuration(s)
+	}
+	days, err := strconv.ParseFloat(m[2], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	d := time.Duration(days * float64(24*time.Hour))
+	if m[3] != "" {
+		rest, err := time.ParseDuration(m[3])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		d += rest
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// durationValue is a flag.Value for durations parsed by ParseDuration.
+type durationValue time.Duration
+
+func (d *durationValue) Set(s string) error {
+	v, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = durationValue(v)
+	return nil
+}
+
+func (d *durationValue) String() string { return time.Duration(*d).String() }
+
+// DurationFlag defines a duration flag as flag.Duration does, whose values
+// may also be in days, as in "1d".
+func DurationFlag(name string, value time.Duration, usage string) *time.Duration {
+	d := new(time.Duration)
+	*d = value
+	flag.Var((*durationValue)(d), name, usage)
+	return d
+}
+
+// TimeBucket returns the start of the time bucket of width d that t is in.
+// Buckets that evenly divide a day are aligned with midnight in t's
+// location.
+func TimeBucket(t time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return t
+	}
+	const day = 24 * time.Hour
+	if day%d != 0 {
+		return t.Truncate(d)
+	}
+	y, m, dd := t.Date()
+	midnight := time.Date(y, m, dd, 0, 0, 0, 0, t.Location())
+	return midnight.Add(t.Sub(midnight).Truncate(d))
+}
+
+// FormatBucket formats the start of a time bucket of width d, omitting
+// parts of the time finer than d.
+func FormatBucket(t time.Time, d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return t.Format("2006-01-02")
+	case d%time.Minute == 0:
+		return t.Format("2006-01-02 15:04")
+	case d%time.Second == 0:
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return t.Format("2006-01-02 15:04:05.000")
+}
+
+// maxBuckets limits the number of buckets TimeBuckets returns, to guard
+// against runaway output due to bad timestamps.
+const maxBuckets = 1 << 20
+
+// TimeBuckets returns the starts of all buckets of width d from the one
+// first is in to the one last is in, in order.
+func TimeBuckets(first, last time.Time, d time.Duration) ([]time.Time, error) {
+	if d <= 0 {
+		return nil, fmt.Errorf("bad time bucket width: %v", d)
+	}
+	var ts []time.Time
+	end := TimeBucket(last, d)
+	for t := TimeBucket(first, d); !t.After(end); t = TimeBucket(t.Add(d), d) {
+		if len(ts) == maxBuckets {
+			return nil, fmt.Errorf("too many time buckets from %v to %v", first, last)
+		}
+		ts = append(ts, t)
+	}
+	return ts, nil
+}
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeParser(t *testing.T) {
+	want := time.Date(2017, 3, 14, 9, 15, 26, 0, time.UTC)
+	for _, d := range []struct {
+		format, in string
+	}{
+		{"rfc3339", "2017-03-14T09:15:26Z"},
+		{"rfc3339", "2017-03-14T10:15:26+01:00"},
+		{"unix", "1489482926"},
+		{"unixms", "1489482926000"},
+		{"clf", "[14/Mar/2017:09:15:26 +0000]"},
+		{"clf", "14/Mar/2017:02:15:26 -0700"},
+		{"2006/01/02 15:04:05 MST", "2017/03/14 09:15:26 UTC"},
+		{"auto", "2017-03-14T09:15:26Z"},
+		{"auto", "1489482926"},
+		{"auto", "1489482926000"},
+		{"auto", "[14/Mar/2017:09:15:26 +0000]"},
+	} {
+		have, err := TimeParser(d.format)(d.in)
+		if err != nil {
+			t.Errorf("TimeParser(%q)(%q) returned unexpected error=%v", d.format, d.in, err)
+			continue
+		}
+		if !have.Equal(want) {
+			t.Errorf("TimeParser(%q)(%q)=%v, want=%v", d.format, d.in, have, want)
+		}
+	}
+
+	have, err := TimeParser("syslog")("Mar 14 09:15:26")
+	if err != nil {
+		t.Fatalf("TimeParser(syslog) returned unexpected error=%v", err)
+	}
+	if have.Year() != time.Now().Year() || have.Month() != time.March || have.Hour() != 9 {
+		t.Errorf("TimeParser(syslog)=%v, want Mar 14 09:15:26 this year", have)
+	}
+
+	for _, d := range []struct {
+		format, in string
+	}{
+		{"auto", "yesterday"},
+		{"unix", "2017-03-14"},
+		{"unix", "NaN"},
+		{"auto", "Inf"},
+		{"rfc3339", "14/Mar/2017:09:15:26 +0000"},
+		{"clf", ""},
+	} {
+		if have, err := TimeParser(d.format)(d.in); err == nil {
+			t.Errorf("TimeParser(%q)(%q)=%v, want error", d.format, d.in, have)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	const day = 24 * time.Hour
+	for _, d := range []struct {
+		in   string
+		want time.Duration
+	}{
+		{"1s", time.Second},
+		{"1m", time.Minute},
+		{"1h30m", 90 * time.Minute},
+		{"1d", day},
+		{"7d", 7 * day},
+		{"1.5d", 36 * time.Hour},
+		{"1d12h", 36 * time.Hour},
+		{"-1d", -day},
+	} {
+		have, err := ParseDuration(d.in)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) returned unexpected error=%v", d.in, err)
+			continue
+		}
+		if have != d.want {
+			t.Errorf("ParseDuration(%q)=%v, want=%v", d.in, have, d.want)
+		}
+	}
+	for _, in := range []string{"", "d", "1", "1x", "1dd", "1d-1h", "1d12"} {
+		if have, err := ParseDuration(in); err == nil {
+			t.Errorf("ParseDuration(%q)=%v, want error", in, have)
+		}
+	}
+}
+
+func TestTimeBucket(t *testing.T) {
+	tm := time.Date(2017, 3, 14, 9, 15, 26, 500e6, time.UTC)
+	for _, d := range []struct {
+		d       time.Duration
+		want    time.Time
+		wantFmt string
+	}{
+		{time.Second, time.Date(2017, 3, 14, 9, 15, 26, 0, time.UTC), "2017-03-14 09:15:26"},
+		{time.Minute, time.Date(2017, 3, 14, 9, 15, 0, 0, time.UTC), "2017-03-14 09:15"},
+		{5 * time.Minute, time.Date(2017, 3, 14, 9, 15, 0, 0, time.UTC), "2017-03-14 09:15"},
+		{time.Hour, time.Date(2017, 3, 14, 9, 0, 0, 0, time.UTC), "2017-03-14 09:00"},
+		{24 * time.Hour, time.Date(2017, 3, 14, 0, 0, 0, 0, time.UTC), "2017-03-14"},
+		{7 * 24 * time.Hour, time.Date(2017, 3, 13, 0, 0, 0, 0, time.UTC), "2017-03-13"},
+		{100 * time.Millisecond, time.Date(2017, 3, 14, 9, 15, 26, 500e6, time.UTC), "2017-03-14 09:15:26.500"},
+	} {
+		have := TimeBucket(tm, d.d)
+		if !have.Equal(d.want) {
+			t.Errorf("TimeBucket(%v, %v)=%v, want=%v", tm, d.d, have, d.want)
+		}
+		if haveFmt := FormatBucket(have, d.d); haveFmt != d.wantFmt {
+			t.Errorf("FormatBucket(%v, %v)=%q, want=%q", have, d.d, haveFmt, d.wantFmt)
+		}
+	}
+}
+
+func TestTimeBuckets(t *testing.T) {
+	first := time.Date(2017, 3, 14, 23, 58, 30, 0, time.UTC)
+	last := time.Date(2017, 3, 15, 0, 1, 10, 0, time.UTC)
+	have, err := TimeBuckets(first, last, time.Minute)
+	if err != nil {
+		t.Fatalf("TimeBuckets returned unexpected error=%v", err)
+	}
+	var want []time.Time
+	for _, m := range []int{58, 59, 60, 61} {
+		want = append(want, time.Date(2017, 3, 14, 23, m, 0, 0, time.UTC))
+	}
+	if !reflect.DeepEqual(have, want) {
+		t.Errorf("TimeBuckets=%v, want=%v", have, want)
+	}
+	if _, err := TimeBuckets(first, last, 0); err == nil {
+		t.Errorf("TimeBuckets(0) returned no error")
+	}
+	if _, err := TimeBuckets(first, first.AddDate(10, 0, 0), time.Second); err == nil {
+		t.Errorf("TimeBuckets(10 years by the second) returned no error")
+	}
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	thousandsRE = regexp.MustCompile(`^[+-]?\d{1,3}(,\d{3})+(\.\d*)?$`)
+	numberRE    = regexp.MustCompile(`^[+-]?(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?$`)
+	unitRE      = regexp.MustCompile(`^([+-]?(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?) ?([kKMGTPE]?)(i?)(B?)$`)
+)
+
+// ParseNumber parses s as a number, allowing for the notations humans and
+// tools such as du -h and docker stats use:
+//
+//   1,234,567       thousands separators
+//   12K, 3.4G       binary multiples (powers of 1024), as du -h prints
+//   12KiB, 3.4Gi    binary multiples
+//   12kB, 3.4GB     decimal multiples (powers of 1000)
+//   12k             decimal multiple
+//   512B            bytes
+//   150ms, 1h30m    durations, in seconds
+//
+// Multiple suffixes are K, M, G, T, P and E. Only decimal notation is
+// accepted: not NaN, infinities or hexadecimal floats.
+func ParseNumber(s string) (float64, error) {
+	if thousandsRE.MatchString(s) {
+		s = strings.Replace(s, ",", "", -1)
+	}
+	if numberRE.MatchString(s) {
+		if n, err := strconv.ParseFloat(s, 64); err == nil {
+			return n, nil
+		}
+	}
+	if m := unitRE.FindStringSubmatch(s); m != nil && (m[2] != "" || m[4] != "") {
+		n, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("not a number: %q", s)
+		}
+		if m[2] == "" {
+			if m[3] != "" {
+				return 0, fmt.Errorf("not a number: %q", s)
+			}
+			return n, nil // just bytes.
+		}
+		base := 1000.0
+		if m[3] != "" || m[4] == "" && m[2] != "k" {
+			base = 1024
+		}
+		exp := strings.Index("KMGTPE", strings.ToUpper(m[2])) + 1
+		return n * math.Pow(base, float64(exp)), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d.Seconds(), nil
+	}
+	return 0, fmt.Errorf("not a number: %q", s)
+}
+
+// FormatNumber formats n as an integer if it is one, with all its digits
+// however large, and to 6 significant digits otherwise.
+func FormatNumber(n float64) string {
+	if n == math.Trunc(n) && !math.IsInf(n, 0) {
+		if n == 0 {
+			return "0" // not -0.
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(n, 'g', 6, 64)
+}
+
+// scaled formats n with the suffix of the largest multiple of base it
+// exceeds, like du -h does: with a single decimal for values under 10.
+func scaled(n, base float64, units string) string {
+	if math.Abs(n) < base {
+		return FormatNumber(n)
+	}
+	u := -1
+	for math.Abs(n) >= base && u < len(units)-1 {
+		n /= base
+		u++
+	}
+	prec := 0
+	if math.Abs(n) < 10 {
+		prec = 1
+	}
+	return strconv.FormatFloat(n, 'f', prec, 64) + units[u:u+1]
+}
+
+// FormatBytes formats n as a number of bytes in binary multiples, such as
+// 1.5K or 12M, like du -h does.
+func FormatBytes(n float64) string { return scaled(n, 1024, "KMGTPE") }
+
+// FormatSI formats n in decimal multiples, such as 1.5k or 12M.
+func FormatSI(n float64) string { return scaled(n, 1000, "kMGTPE") }
+
+// FormatDuration formats n seconds as a duration, such as 1m30s or 150ms.
+func FormatDuration(n float64) string {
+	return time.Duration(n * float64(time.Second)).String()
+}
+
+// Formatter returns the number formatter called name: "bytes", "si",
+// "duration", or "" for FormatNumber.
+func Formatter(name string) (func(float64) string, error) {
+	switch name {
+	case "":
+		return FormatNumber, nil
+	case "bytes":
+		return FormatBytes, nil
+	case "si":
+		return FormatSI, nil
+	case "duration":
+		return FormatDuration, nil
+	}
+	return nil, fmt.Errorf("unknown number format %q", name)
+}
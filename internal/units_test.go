@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestParseNumber(t *testing.T) {
+	for _, d := range []struct {
+		in   string
+		want float64
+	}{
+		{"42", 42},
+		{"-1.5", -1.5},
+		{"1,234,567", 1234567},
+		{"1,234.5", 1234.5},
+		{"12K", 12 * 1024},
+		{"1.5M", 1.5 * 1024 * 1024},
+		{"3G", 3 << 30},
+		{"12KiB", 12 * 1024},
+		{"2Gi", 2 << 30},
+		{"12kB", 12000},
+		{"3.4GB", 3.4e9},
+		{"12k", 12000},
+		{"512B", 512},
+		{"1.5 MB", 1.5e6},
+		{"150ms", 0.15},
+		{"1h30m", 5400},
+		{"5m", 300},
+		{"2µs", 2e-6},
+	} {
+		have, err := ParseNumber(d.in)
+		if err != nil {
+			t.Errorf("ParseNumber(%q) returned unexpected error=%v", d.in, err)
+			continue
+		}
+		if have != d.want {
+			t.Errorf("ParseNumber(%q)=%v, want=%v", d.in, have, d.want)
+		}
+	}
+	for _, in := range []string{"", "abc", "1,2", "12X", "12i", "1.2.3", "K", "NaN", "nan", "Inf", "-Infinity", "0x1p4", "0x10", "1e999"} {
+		if n, err := ParseNumber(in); err == nil {
+			t.Errorf("ParseNumber(%q)=%v, want error", in, n)
+		}
+	}
+}
+
+func TestFormatters(t *testing.T) {
+	for _, d := range []struct {
+		format string
+		in     float64
+		want   string
+	}{
+		{"", 42, "42"},
+		{"", 1.0 / 3, "0.333333"},
+		{"", 1e15, "1000000000000000"},
+		{"", 1234567890123456, "1234567890123456"},
+		{"", -1e20, "-100000000000000000000"},
+		{"bytes", 1000, "1000"},
+		{"bytes", 1536, "1.5K"},
+		{"bytes", 3.4 * (1 << 30), "3.4G"},
+		{"bytes", 12345678, "12M"},
+		{"bytes", -2048, "-2.0K"},
+		{"si", 1500, "1.5k"},
+		{"si", 12e6, "12M"},
+		{"duration", 0.15, "150ms"},
+		{"duration", 5400, "1h30m0s"},
+	} {
+		f, err := Formatter(d.format)
+		if err != nil {
+			t.Fatalf("Formatter(%q) returned unexpected error=%v", d.format, err)
+		}
+		if have := f(d.in); have != d.want {
+			t.Errorf("Formatter(%q)(%v)=%q, want=%q", d.format, d.in, have, d.want)
+		}
+	}
+	if _, err := Formatter("roman"); err == nil {
+		t.Errorf("Formatter(roman) returned no error")
+	}
+}
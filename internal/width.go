@@ -0,0 +1,154 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gaal/shstat/ucd"
+)
+
+// RuneWidth returns the number of terminal cells r takes to display: 2 for
+// East Asian wide and fullwidth characters, by their East_Asian_Width in
+// package ucd, 0 for combining marks, format and control characters, and 1
+// otherwise.
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x20 || r >= 0x7f && r < 0xa0:
+		return 0
+	case r < 0x300: // the common case.
+		return 1
+	case r >= 0x1160 && r <= 0x11ff: // Hangul medial vowels and final consonants.
+		return 0
+	case r == 0x200b || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+	if w := ucd.EastAsianWidth(r); w == "W" || w == "F" {
+		return 2
+	}
+	return 1
+}
+
+// Width returns the number of terminal cells s takes to display.
+func Width(s string) int {
+	var n int
+	for _, r := range s {
+		n += RuneWidth(r)
+	}
+	return n
+}
+
+// Pad pads s with spaces to width cells, on the left if right is set.
+func Pad(s string, width int, right bool) string {
+	n := width - Width(s)
+	if n <= 0 {
+		return s
+	}
+	pad := make([]byte, n)
+	for i := range pad {
+		pad[i] = ' '
+	}
+	if right {
+		return string(pad) + s
+	}
+	return s + string(pad)
+}
+
+// SnipMark marks the place where snipped text was removed.
+const SnipMark = "…"
+
+// Snip shortens s to fit in width cells, if needed, by replacing its end
+// with SnipMark. It reports whether s was shortened.
+func Snip(s string, width int) (string, bool) {
+	if Width(s) <= width {
+		return s, false
+	}
+	var n int
+	for i, r := range s {
+		rw := RuneWidth(r)
+		if n+rw > width-1 {
+			return s[:i] + SnipMark, true
+		}
+		n += rw
+	}
+	return s, false
+}
+
+// SnipTail is like Snip, but keeps the end of s instead of its start.
+func SnipTail(s string, width int) (string, bool) {
+	if Width(s) <= width {
+		return s, false
+	}
+	n := Width(SnipMark)
+	i := len(s)
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:i])
+		if n+RuneWidth(r) > width {
+			break
+		}
+		n += RuneWidth(r)
+		i -= size
+	}
+	return SnipMark + s[i:], true
+}
+
+// SnipMiddle is like Snip, but keeps the start and end of s, replacing its
+// middle with SnipMark.
+func SnipMiddle(s string, width int) (string, bool) {
+	if Width(s) <= width {
+		return s, false
+	}
+	avail := width - Width(SnipMark)
+	head, _ := Snip(s, (avail+1)/2+Width(SnipMark))
+	tail, _ := SnipTail(s, avail/2+Width(SnipMark))
+	return head + strings.TrimPrefix(tail, SnipMark), true
+}
+
+// SnipPath is like SnipMiddle, for slash-separated paths and URLs: it
+// replaces whole components in the middle of s with SnipMark, as in
+// /usr/…/lib/file.go, keeping as many components at either end as fit. If
+// not even the first and last components fit, it falls back to SnipMiddle.
+func SnipPath(s string, width int) (string, bool) {
+	if Width(s) <= width {
+		return s, false
+	}
+	parts := strings.Split(s, "/")
+	join := func(i, j int) string {
+		return strings.Join(append(append(parts[:i:i], SnipMark), parts[j:]...), "/")
+	}
+	i, j := 1, len(parts)-1
+	if len(parts) < 3 || Width(join(i, j)) > width {
+		return SnipMiddle(s, width)
+	}
+	for {
+		grew := false
+		if j-1 > i && Width(join(i, j-1)) <= width {
+			j--
+			grew = true
+		}
+		if i+1 < j && Width(join(i+1, j)) <= width {
+			i++
+			grew = true
+		}
+		if !grew {
+			return join(i, j), true
+		}
+	}
+}
+
+// Snipper returns the snippeting function called name: "head" for Snip,
+// "tail" for SnipTail, "middle" for SnipMiddle or "path" for SnipPath.
+func Snipper(name string) (func(s string, width int) (string, bool), error) {
+	switch name {
+	case "head":
+		return Snip, nil
+	case "tail":
+		return SnipTail, nil
+	case "middle":
+		return SnipMiddle, nil
+	case "path":
+		return SnipPath, nil
+	}
+	return nil, fmt.Errorf("unknown snippet strategy %q", name)
+}
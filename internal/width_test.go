@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestWidth(t *testing.T) {
+	for _, d := range []struct {
+		in   string
+		want int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"na\u00efve", 5},
+		{"nai\u0308ve", 5}, // combining diaeresis.
+		{"日本語", 6},
+		{"ｶﾀｶﾅ", 4}, // halfwidth.
+		{"ＡＢ", 4},   // fullwidth.
+		{"한국", 4},
+		{"\u1100\u1161", 2}, // conjoining jamo.
+		{"😀!", 3},
+		{"a\tb", 2},
+		{"zero\u200bwidth", 9},
+	} {
+		if have := Width(d.in); have != d.want {
+			t.Errorf("Width(%q)=%d, want=%d", d.in, have, d.want)
+		}
+	}
+}
+
+func TestSnip(t *testing.T) {
+	for _, d := range []struct {
+		in      string
+		width   int
+		want    string
+		snipped bool
+	}{
+		{"abc", 3, "abc", false},
+		{"abcd", 3, "ab…", true},
+		{"日本語", 6, "日本語", false},
+		{"日本語", 5, "日本…", true},
+		{"日本語", 4, "日…", true},
+		{"a日本", 3, "a…", true},
+	} {
+		have, snipped := Snip(d.in, d.width)
+		if have != d.want || snipped != d.snipped {
+			t.Errorf("Snip(%q, %d)=%q, %v, want=%q, %v", d.in, d.width, have, snipped, d.want, d.snipped)
+		}
+	}
+	if have := Pad("日本", 6, false) + "|"; have != "日本  |" {
+		t.Errorf("Pad(left)=%q", have)
+	}
+	if have := Pad("日本", 6, true); have != "  日本" {
+		t.Errorf("Pad(right)=%q", have)
+	}
+}
+
+func TestSnippers(t *testing.T) {
+	for _, d := range []struct {
+		how   string
+		in    string
+		width int
+		want  string
+	}{
+		{"head", "/usr/local/lib/file.go", 10, "/usr/loca…"},
+		{"tail", "/usr/local/lib/file.go", 10, "…b/file.go"},
+		{"tail", "/usr/local/lib/file.go", 22, "/usr/local/lib/file.go"},
+		{"tail", "日本語です", 6, "…です"},
+		{"middle", "/usr/local/lib/file.go", 10, "/usr/…e.go"},
+		{"middle", "/usr/local/lib/file.go", 11, "/usr/…le.go"},
+		{"path", "/usr/local/lib/file.go", 18, "/usr/…/lib/file.go"},
+		{"path", "/usr/local/lib/file.go", 14, "/…/lib/file.go"},
+		{"path", "/usr/local/lib/file.go", 10, "/…/file.go"},
+		{"path", "/usr/local/lib/file.go", 8, "/usr….go"},
+		{"path", "http://example.com/a/b/c/index.html", 30, "http://…/a/b/c/index.html"},
+		{"path", "no-slashes-at-all", 9, "no-s…-all"},
+	} {
+		f, err := Snipper(d.how)
+		if err != nil {
+			t.Fatalf("Snipper(%q) returned unexpected error=%v", d.how, err)
+		}
+		have, _ := f(d.in, d.width)
+		if have != d.want {
+			t.Errorf("Snipper(%q)(%q, %d)=%q, want=%q", d.how, d.in, d.width, have, d.want)
+		}
+		if w := Width(have); w > d.width {
+			t.Errorf("Snipper(%q)(%q, %d) is %d cells wide", d.how, d.in, d.width, w)
+		}
+	}
+	if _, err := Snipper("sideways"); err == nil {
+		t.Errorf("Snipper(sideways) returned no error")
+	}
+}
@@ -0,0 +1,225 @@
+/*
+sample prints a random sample of its input lines.
+
+  # Print 10 lines chosen uniformly at random, in input order.
+  $ sample < huge.log
+
+  # Print 1000 lines, reproducibly: the same seed gives the same sample.
+  $ sample -n 1000 -seed 42 < huge.log
+
+  # Print every 100th line, starting from a random one of the first 100.
+  $ sample -every 100 < huge.log
+
+  # Stratify by the 9th field: sample up to 5 lines per status code.
+  $ sample -n 5 -by 9 < access.log
+
+  # Strata may be composite, or taken from the capture groups of a regexp.
+  # Lines that do not match the regexp are skipped, with or without -by.
+  $ sample -n 5 -re 'user=(\S+) .*status=(\d+)' -by 2 < app.log
+  $ sample -re 'status=5\d\d' < app.log
+
+Random samples are taken by reservoir sampling, so they need memory for the
+sampled lines only, however long the input. Without -seed, samples differ from
+run to run.
+*/
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gaal/shstat/internal"
+)
+
+var (
+	inDelim = flag.String("ifs", `\s+`, "input field delimiter (regexp)")
+	extract = flag.String("re", "", "only sample lines matching this regexp, and take -by fields from its capture groups instead of splitting by -ifs")
+	header  = flag.Bool("header", false, "input starts with a header line, which is always printed")
+
+	size   = flag.Int("n", 10, "number of lines to sample (per stratum, with -by)")
+	every  = flag.Int("every", 0, "sample every k-th line instead, starting from a random one of the first k")
+	seed   = flag.Int64("seed", 0, "random seed, for reproducible samples. Zero to seed from the current time")
+	byspec = flag.String("by", "", "stratum fields, comma separated. Samples each distinct stratum separately")
+)
+
+// sampler samples lines, either randomly or systematically.
+type sampler struct {
+	n      int // lines per stratum, for random sampling.
+	every  int // sampling interval, for systematic sampling. Zero for random.
+	rng    *rand.Rand
+	by     *internal.Parter // stratum fields. nil for a single stratum.
+	match  *regexp.Regexp   // lines must match this to be sampled. nil for all.
+	header bool
+}
+
+type sampled struct {
+	nr   int // line number.
+	line string
+}
+
+// reservoir is a uniform random sample of the lines seen in a stratum.
+type reservoir struct {
+	seen  int
+	lines []sampled
+}
+
+// add adds a line to the reservoir, keeping each of the lines seen so far with
+// equal probability.
+func (r *reservoir) add(rng *rand.Rand, n int, s sampled) {
+	r.seen++
+	if len(r.lines) < n {
+		r.lines = append(r.lines, s)
+		return
+	}
+	if j := rng.Intn(r.seen); j < n {
+		r.lines[j] = s
+	}
+}
+
+type byLineNr []sampled
+
+func (a byLineNr) Len() int           { return len(a) }
+func (a byLineNr) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byLineNr) Less(i, j int) bool { return a[i].nr < a[j].nr }
+
+func (sp *sampler) sample(in io.Reader, w io.Writer) error {
+	strata := make(map[string]*reservoir)
+	counts := make(map[string]int) // systematic sampling: lines seen per stratum.
+	starts := make(map[string]int) // systematic sampling: first sampled line per stratum.
+	s := bufio.NewScanner(in)
+	var nlines int
+	for s.Scan() {
+		nlines++
+		line := s.Bytes()
+		if nlines == 1 && sp.header {
+			if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
+				return err
+			}
+			continue
+		}
+		if sp.match != nil && !sp.match.Match(line) {
+			continue
+		}
+		var k string
+		if sp.by != nil {
+			f := sp.by.Fields(line)
+			if f == nil {
+				continue
+			}
+			k = string(bytes.Join(f, []byte{0}))
+		}
+		if sp.every > 0 {
+			start, ok := starts[k]
+			if !ok {
+				start = sp.rng.Intn(sp.every)
+				starts[k] = start
+			}
+			c := counts[k]
+			counts[k]++
+			if c >= start && (c-start)%sp.every == 0 {
+				if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		r := strata[k]
+		if r == nil {
+			r = &reservoir{}
+			strata[k] = r
+		}
+		r.add(sp.rng, sp.n, sampled{nr: nlines, line: string(line)})
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	var all []sampled
+	for _, r := range strata {
+		all = append(all, r.lines...)
+	}
+	sort.Sort(byLineNr(all))
+	for _, v := range all {
+		if _, err := fmt.Fprintln(w, v.line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	internal.SetUsage(
+		`sample prints a random sample of its input lines.
+
+  # Print 10 lines chosen uniformly at random, in input order.
+  $ sample < huge.log
+
+  # Print 1000 lines, reproducibly: the same seed gives the same sample.
+  $ sample -n 1000 -seed 42 < huge.log
+
+  # Print every 100th line, starting from a random one of the first 100.
+  $ sample -every 100 < huge.log
+
+  # Stratify by the 9th field: sample up to 5 lines per status code.
+  $ sample -n 5 -by 9 < access.log
+
+  # Strata may be composite, or taken from the capture groups of a regexp.
+  # Lines that do not match the regexp are skipped, with or without -by.
+  $ sample -n 5 -re 'user=(\S+) .*status=(\d+)' -by 2 < app.log
+  $ sample -re 'status=5\d\d' < app.log
+
+Random samples are taken by reservoir sampling, so they need memory for the
+sampled lines only, however long the input. Without -seed, samples differ from
+run to run.
+`)
+	flag.Parse()
+
+	if *size < 0 || *every < 0 {
+		fmt.Fprintln(os.Stderr, "-n and -every cannot be negative")
+		os.Exit(1)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	sp := &sampler{
+		n:      *size,
+		every:  *every,
+		rng:    rand.New(rand.NewSource(*seed)),
+		header: *header,
+	}
+	if *extract != "" {
+		sp.match = regexp.MustCompile(*extract)
+	}
+	if *byspec != "" {
+		keys := strings.Split(*byspec, ",")
+		if *extract != "" {
+			idx, err := internal.SubexpList(sp.match, keys)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			sp.by = internal.NewMatchParter(sp.match, idx)
+		} else {
+			idx, err := internal.AtoiList(keys)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			*inDelim = strings.Replace(*inDelim, `\t`, "\t", -1)
+			sp.by = internal.NewParter(regexp.MustCompile(*inDelim), idx)
+		}
+	}
+	if err := sp.sample(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gaal/shstat/internal"
+)
+
+func numbered(n int, f func(i int) string) string {
+	var lines []string
+	for i := 0; i < n; i++ {
+		lines = append(lines, f(i))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func run(t *testing.T, sp *sampler, in string) []string {
+	have := &bytes.Buffer{}
+	if err := sp.sample(bytes.NewBufferString(in), have); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	return strings.Fields(have.String())
+}
+
+func TestReservoir(t *testing.T) {
+	in := numbered(1000, func(i int) string { return fmt.Sprint(i) })
+	have := run(t, &sampler{n: 10, rng: rand.New(rand.NewSource(42))}, in)
+	if len(have) != 10 {
+		t.Fatalf("sample(n=10) returned %d lines, want 10: %q", len(have), have)
+	}
+	var prev int
+	for i, v := range have {
+		var n int
+		fmt.Sscan(v, &n)
+		if i > 0 && n <= prev {
+			t.Errorf("sample returned lines out of order: %q", have)
+			break
+		}
+		prev = n
+	}
+	again := run(t, &sampler{n: 10, rng: rand.New(rand.NewSource(42))}, in)
+	if !reflect.DeepEqual(have, again) {
+		t.Errorf("sample with the same seed returned different lines.\nhave=%q\nthen=%q", have, again)
+	}
+	short := run(t, &sampler{n: 10, rng: rand.New(rand.NewSource(42))}, "a\nb\nc")
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(short, want) {
+		t.Errorf("sample of short input=%q, want=%q", short, want)
+	}
+}
+
+func TestReservoirUniform(t *testing.T) {
+	// Each of 10 lines should be picked about a third of the time for n=3.
+	in := numbered(10, func(i int) string { return fmt.Sprint(i) })
+	hits := make(map[string]int)
+	rng := rand.New(rand.NewSource(1))
+	const runs = 3000
+	for i := 0; i < runs; i++ {
+		for _, v := range run(t, &sampler{n: 3, rng: rng}, in) {
+			hits[v]++
+		}
+	}
+	for k, v := range hits {
+		if v < runs*3/10*8/10 || v > runs*3/10*12/10 {
+			t.Errorf("line %s sampled %d times in %d runs, want about %d", k, v, runs, runs*3/10)
+		}
+	}
+}
+
+func TestStratified(t *testing.T) {
+	in := numbered(300, func(i int) string { return fmt.Sprintf("%d %d", i, i%3) })
+	sp := &sampler{
+		n:   2,
+		rng: rand.New(rand.NewSource(7)),
+		by:  internal.NewParter(regexp.MustCompile(" "), []int{2}),
+	}
+	have := run(t, sp, in)
+	strata := make(map[string]int)
+	for i := 1; i < len(have); i += 2 {
+		strata[have[i]]++
+	}
+	if want := map[string]int{"0": 2, "1": 2, "2": 2}; !reflect.DeepEqual(strata, want) {
+		t.Errorf("sample(by=2) sampled strata %v, want=%v", strata, want)
+	}
+}
+
+func TestSystematic(t *testing.T) {
+	in := "h\n" + numbered(20, func(i int) string { return fmt.Sprint(i) })
+	have := run(t, &sampler{every: 5, rng: rand.New(rand.NewSource(3)), header: true}, in)
+	if len(have) != 5 || have[0] != "h" {
+		t.Fatalf("sample(every=5) returned %q, want a header and 4 lines", have)
+	}
+	var first int
+	fmt.Sscan(have[1], &first)
+	for i, v := range have[1:] {
+		if want := fmt.Sprint(first + 5*i); v != want {
+			t.Errorf("sample(every=5) returned %q, want every 5th line from %d", have, first)
+			break
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	in := numbered(100, func(i int) string { return fmt.Sprintf("%d status=%d", i, 200+300*(i%2)) })
+	have := run(t, &sampler{n: 10, rng: rand.New(rand.NewSource(5)), match: regexp.MustCompile(`status=500`)}, in)
+	if len(have) != 20 {
+		t.Fatalf("sample(re) returned %q, want 10 lines", have)
+	}
+	for i := 1; i < len(have); i += 2 {
+		if have[i] != "status=500" {
+			t.Errorf("sample(re) returned line %q %q, which does not match", have[i-1], have[i])
+		}
+	}
+}
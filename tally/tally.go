@@ -18,7 +18,33 @@ tally computes sums of columns.
   $ tally 2 3 < mytable
   134 61
 
-Currently only integer data is supported.
+  # Sums numbers extracted from unstructured input by a regexp. Columns are
+  # its capture groups, numbered or named. Lines that do not match are skipped.
+  $ tally -re 'bytes=(\d+)' < access.log
+  $ tally -re 'in=(?P<in>\d+) out=(?P<out>\d+)' out in < traffic.log
+
+  # Sums only the sizes of files owned by root. With -header, the first line
+  # names fields and is otherwise skipped.
+  $ ls -l | tally -where '$3 == root' 5
+  $ tally -header -where '$status >= 500' 4 < table.txt
+
+  # Sums human-readable sizes and durations, and prints them likewise.
+  $ du -sh * | tally -human=bytes
+  3.4G
+  $ tally -human=duration 3 < timings.txt
+  1m12.5s
+
+  # Sums bytes per minute of a web server log, one row per minute in time
+  # order. The timestamp may span several fields; -agg=rate gives per-second
+  # rates and -agg=avg averages per line instead.
+  $ tally -time 4,5 -tfmt clf -window 1m -human=bytes 10 < access.log
+  2017-03-14 09:15 1.2M
+  2017-03-14 09:16 0
+  2017-03-14 09:17 3.4M
+
+Numbers may have thousands separators (1,234,567), binary or decimal
+multiple suffixes (12K, 3.4GiB, 12kB), or be durations (150ms, 1h30m), which
+are counted in seconds.
 */
 package main
 
@@ -29,8 +55,8 @@ import (
 	"io"
 	"os"
 	"regexp"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gaal/shstat/internal"
 )
@@ -39,30 +65,67 @@ var (
 	inDelim  = flag.String("ifs", `\s+`, "input field delimiter (regexp)")
 	outDelim = flag.String("ofs", " ", "output field separator (string)")
 	quiet    = flag.Bool("q", false, "silence warnings on bad input")
+	extract  = flag.String("re", "", "take columns from the capture groups of this regexp instead of splitting by -ifs")
+	where    = flag.String("where", "", "only process lines matching this filter expression")
+	header   = flag.Bool("header", false, "input starts with a header line naming its fields")
+	human    = flag.String("human", "", "print sums in human-readable units {bytes, si, duration}")
+	timespec = flag.String("time", "", "timestamp fields, comma separated. Prints a row of sums per time window")
+	tfmt     = flag.String("tfmt", "auto", "timestamp format {auto, rfc3339, unix, unixms, clf, syslog} or a Go time layout")
+	width    = internal.DurationFlag("window", time.Minute, "time window width, with -time: a `duration` such as 1s, 5m, 1h or 1d")
+	agg      = flag.String("agg", "sum", "with -time, what to print per window {sum, rate (per second), avg (per line)}")
 )
 
-func tally(in io.Reader, w io.Writer, ifs *regexp.Regexp, ofs string, idx []int) error {
-	sums := make([]int64, len(idx))
-	p := internal.NewParter(ifs, idx)
+// scan calls add with the number and the text of each line in in that
+// matches f, other than a header line, and the values p takes from it.
+// ok[i] reports whether vals[i] parsed; bad values are reported, unless
+// -q is set.
+func scan(in io.Reader, p *internal.Parter, f *internal.Filter, ncols int, add func(nline int, line []byte, vals []float64, ok []bool)) error {
+	vals := make([]float64, ncols)
+	ok := make([]bool, ncols)
 	s := bufio.NewScanner(in)
 	var nlines int
 	for s.Scan() {
 		var bad bool
 		nlines++
-		parts := p.Fields(s.Bytes())
-		for i, v := range parts {
-			n, err := strconv.ParseInt(string(v), 10, 64)
+		line := s.Bytes()
+		if nlines == 1 && *header {
+			if err := f.SetHeader(line); err != nil {
+				return err
+			}
+			continue
+		}
+		if !f.Match(line) {
+			continue
+		}
+		for i := range ok {
+			ok[i] = false
+		}
+		for i, v := range p.Fields(line) {
+			n, err := internal.ParseNumber(string(v))
 			if err != nil {
 				bad = true
 				continue
 			}
-			sums[i] += n
+			vals[i], ok[i] = n, true
 		}
 		if bad && !*quiet {
 			fmt.Fprintf(os.Stderr, "bad input: line %d\n", nlines)
 		}
+		add(nlines, line, vals, ok)
 	}
-	if err := s.Err(); err != nil {
+	return s.Err()
+}
+
+func tally(in io.Reader, w io.Writer, p *internal.Parter, f *internal.Filter, ofs string, ncols int, format func(float64) string) error {
+	sums := make([]float64, ncols)
+	err := scan(in, p, f, ncols, func(_ int, _ []byte, vals []float64, ok []bool) {
+		for i, v := range vals {
+			if ok[i] {
+				sums[i] += v
+			}
+		}
+	})
+	if err != nil {
 		return err
 	}
 	for i, v := range sums {
@@ -70,7 +133,7 @@ func tally(in io.Reader, w io.Writer, ifs *regexp.Regexp, ofs string, idx []int)
 		if i > 0 {
 			sep = ofs
 		}
-		if _, err := fmt.Fprintf(w, "%s%d", sep, v); err != nil {
+		if _, err := fmt.Fprintf(w, "%s%s", sep, format(v)); err != nil {
 			return err
 		}
 	}
@@ -88,17 +151,63 @@ func main() {
 	}
 	flag.Parse()
 
-	ifs := regexp.MustCompile(*inDelim)
 	*outDelim = strings.Replace(*outDelim, `\t`, "\t", -1) // silent magic for now, figure it out later.
-	idx, err := internal.AtoiList(flag.Args())
+	keys := flag.Args()
+	if len(keys) == 0 {
+		keys = []string{"1"}
+	}
+	parter := func(keys []string) *internal.Parter {
+		if *extract != "" {
+			re := regexp.MustCompile(*extract)
+			idx, err := internal.SubexpList(re, keys)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return internal.NewMatchParter(re, idx)
+		}
+		idx, err := internal.AtoiList(keys)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return internal.NewParter(regexp.MustCompile(*inDelim), idx)
+	}
+	p := parter(keys)
+	f, err := internal.ParseFilter(*where, p, *header)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
-	if len(idx) == 0 {
-		idx = []int{1}
+	format, err := internal.Formatter(*human)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *timespec != "" {
+		switch *agg {
+		case "sum", "rate", "avg":
+		default:
+			fmt.Fprintf(os.Stderr, "bad -agg=%q\n", *agg)
+			os.Exit(1)
+		}
+		if *width <= 0 {
+			fmt.Fprintf(os.Stderr, "bad -window=%v\n", *width)
+			os.Exit(1)
+		}
+		wd := windower{
+			p:     parter(strings.Split(*timespec, ",")),
+			parse: internal.TimeParser(*tfmt),
+			width: *width,
+			agg:   *agg,
+		}
+		if err := wd.tally(os.Stdin, os.Stdout, p, f, *outDelim, len(keys), format); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
 	}
-	if err := tally(os.Stdin, os.Stdout, ifs, *outDelim, idx); err != nil {
+	if err := tally(os.Stdin, os.Stdout, p, f, *outDelim, len(keys), format); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"regexp"
 	"testing"
+	"time"
+
+	"github.com/gaal/shstat/internal"
 )
 
 func TestFld(t *testing.T) {
@@ -21,10 +24,94 @@ func TestFld(t *testing.T) {
 	re := regexp.MustCompile(" +")
 	ofs := " "
 	have := &bytes.Buffer{}
-	if err := tally(bytes.NewBufferString(in), have, re, ofs, spec); err != nil {
+	if err := tally(bytes.NewBufferString(in), have, internal.NewParter(re, spec), nil, ofs, len(spec), internal.FormatNumber); err != nil {
 		t.Fatalf("unexpected error=%v", err)
 	}
 	if have.String() != want {
 		t.Errorf("fld returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
 	}
 }
+
+func TestTallyMatch(t *testing.T) {
+	in := `GET /a bytes=100
+GET /b bytes=20
+HEAD /a
+GET /c bytes=3`
+	want := "123\n"
+	re := regexp.MustCompile(`bytes=(\d+)`)
+	have := &bytes.Buffer{}
+	if err := tally(bytes.NewBufferString(in), have, internal.NewMatchParter(re, []int{1}), nil, " ", 1, internal.FormatNumber); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("tally returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestTallyWhere(t *testing.T) {
+	in := `name size
+a 100
+b 2000
+c 30000`
+	want := "32000\n"
+	p := internal.NewParter(regexp.MustCompile(" +"), []int{2})
+	f, err := internal.ParseFilter("$size > 1000", p, true)
+	if err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	*header = true
+	defer func() { *header = false }()
+	have := &bytes.Buffer{}
+	if err := tally(bytes.NewBufferString(in), have, p, f, " ", 1, internal.FormatNumber); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("tally returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestTallyHuman(t *testing.T) {
+	in := `1.5K a
+2M b
+1,024 c
+512B d`
+	want := "2.0M\n"
+	have := &bytes.Buffer{}
+	p := internal.NewParter(regexp.MustCompile(" +"), []int{1})
+	if err := tally(bytes.NewBufferString(in), have, p, nil, " ", 1, internal.FormatBytes); err != nil {
+		t.Fatalf("unexpected error=%v", err)
+	}
+	if have.String() != want {
+		t.Errorf("tally returned wrong results.\nhave=%q,\nwant=%q", have.String(), want)
+	}
+}
+
+func TestTallyWindows(t *testing.T) {
+	in := `2017-03-14T09:15:26Z 100 1
+2017-03-14T09:15:59Z 200 1
+2017-03-14T09:17:01Z 60 2
+2017-03-14T09:17:30Z x 4`
+	re := regexp.MustCompile(" +")
+	for _, d := range []struct {
+		agg  string
+		want string
+	}{
+		{"sum", "2017-03-14 09:15 300 2\n2017-03-14 09:16 0 0\n2017-03-14 09:17 60 6\n"},
+		{"rate", "2017-03-14 09:15 5 0.0333333\n2017-03-14 09:16 0 0\n2017-03-14 09:17 1 0.1\n"},
+		{"avg", "2017-03-14 09:15 150 1\n2017-03-14 09:16 0 0\n2017-03-14 09:17 60 3\n"},
+	} {
+		wd := windower{
+			p:     internal.NewParter(re, []int{1}),
+			parse: internal.TimeParser("rfc3339"),
+			width: time.Minute,
+			agg:   d.agg,
+		}
+		have := &bytes.Buffer{}
+		if err := wd.tally(bytes.NewBufferString(in), have, internal.NewParter(re, []int{2, 3}), nil, " ", 2, internal.FormatNumber); err != nil {
+			t.Fatalf("unexpected error=%v", err)
+		}
+		if have.String() != d.want {
+			t.Errorf("tally(-agg=%s) returned wrong results.\nhave=%q,\nwant=%q", d.agg, have.String(), d.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gaal/shstat/internal"
+)
+
+// windower tallies columns over consecutive time windows.
+type windower struct {
+	p     *internal.Parter // timestamp fields, joined by a space for parsing.
+	parse func(string) (time.Time, error)
+	width time.Duration
+	agg   string // "sum", "rate" (per second) or "avg".
+}
+
+type window struct {
+	sums []float64
+	n    []int // lines whose value parsed, per column.
+}
+
+// tally is like the top-level tally, but prints a row per time window from the
+// first timestamp seen to the last, in order. Each row starts with the start
+// of its window.
+func (wd windower) tally(in io.Reader, w io.Writer, p *internal.Parter, f *internal.Filter, ofs string, ncols int, format func(float64) string) error {
+	wins := make(map[int64]*window) // by window start, in Unix ns.
+	var first, last time.Time
+	err := scan(in, p, f, ncols, func(nline int, line []byte, vals []float64, ok []bool) {
+		tf := wd.p.Fields(line)
+		if tf == nil {
+			return
+		}
+		t, err := wd.parse(string(bytes.Join(tf, []byte(" "))))
+		if err != nil {
+			if !*quiet {
+				fmt.Fprintf(os.Stderr, "bad timestamp: line %d\n", nline)
+			}
+			return
+		}
+		t = internal.TimeBucket(t, wd.width)
+		win := wins[t.UnixNano()]
+		if win == nil {
+			win = &window{sums: make([]float64, ncols), n: make([]int, ncols)}
+			wins[t.UnixNano()] = win
+		}
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if t.After(last) {
+			last = t
+		}
+		for i, v := range vals {
+			if ok[i] {
+				win.sums[i] += v
+				win.n[i]++
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if len(wins) == 0 {
+		return nil
+	}
+
+	ts, err := internal.TimeBuckets(first, last, wd.width)
+	if err != nil {
+		return err
+	}
+	for _, t := range ts {
+		if _, err := fmt.Fprint(w, internal.FormatBucket(t, wd.width)); err != nil {
+			return err
+		}
+		win := wins[t.UnixNano()]
+		for i := 0; i < ncols; i++ {
+			var v float64
+			if win != nil {
+				v = win.sums[i]
+				switch wd.agg {
+				case "rate":
+					v /= wd.width.Seconds()
+				case "avg":
+					if win.n[i] > 0 {
+						v /= float64(win.n[i])
+					}
+				}
+			}
+			if _, err := fmt.Fprintf(w, "%s%s", ofs, format(v)); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,491 @@
+//go:build ignore
+// +build ignore
+
+// genucd generates the data files of package ucd from a local copy of the
+// Unicode Character Database, either a directory or a zip file such as
+// https://www.unicode.org/Public/zipped/latest/UCD.zip:
+//
+//   go run genucd.go UCD.zip
+//
+// It writes ucd_data.go, with the UnicodeData.txt records in the encoding
+// genData describes, and ucd_tables.go, with the properties read from the
+// other files.
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var outDir = flag.String("o", ".", "directory to write generated files to")
+
+// files are the UCD files read, by base name. emoji-data.txt is in the
+// emoji subdirectory of the UCD.
+var files = []string{
+	"UnicodeData.txt",
+	"Blocks.txt",
+	"Scripts.txt",
+	"NameAliases.txt",
+	"EastAsianWidth.txt",
+	"emoji-data.txt",
+	"DerivedAge.txt",
+	"PropList.txt",
+}
+
+// source opens UCD files in a directory or zip file.
+type source struct {
+	paths map[string]string
+	zips  map[string]*zip.File
+}
+
+func openSource(path string) (*source, error) {
+	s := &source{paths: map[string]string{}, zips: map[string]*zip.File{}}
+	if strings.HasSuffix(path, ".zip") {
+		z, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range z.File {
+			s.zips[filepath.Base(f.Name)] = f
+		}
+	} else {
+		err := filepath.Walk(path, func(p string, fi os.FileInfo, err error) error {
+			if err == nil && !fi.IsDir() {
+				s.paths[fi.Name()] = p
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range files {
+		if s.paths[name] == "" && s.zips[name] == nil {
+			return nil, fmt.Errorf("%s: %s not found", path, name)
+		}
+	}
+	return s, nil
+}
+
+func (s *source) open(name string) (io.ReadCloser, error) {
+	if f := s.zips[name]; f != nil {
+		return f.Open()
+	}
+	return os.Open(s.paths[name])
+}
+
+var versionRE = regexp.MustCompile(`^# \S+-(\d+\.\d+\.\d+)\.txt`)
+
+// version returns the Unicode version named in the header of a UCD file,
+// such as "# Blocks-14.0.0.txt".
+func (s *source) version() (string, error) {
+	for _, name := range files {
+		rc, err := s.open(name)
+		if err != nil {
+			return "", err
+		}
+		line, _ := bufio.NewReader(rc).ReadString('\n')
+		rc.Close()
+		if m := versionRE.FindStringSubmatch(line); m != nil {
+			return m[1], nil
+		}
+	}
+	return "", fmt.Errorf("no UCD file names its Unicode version")
+}
+
+// parse calls f with the semicolon-separated fields of each line of a UCD
+// file, with comments and surrounding space removed.
+func (s *source) parse(name string, f func(fields []string) error) error {
+	rc, err := s.open(name)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	sc := bufio.NewScanner(rc)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i, v := range fields {
+			fields[i] = strings.TrimSpace(v)
+		}
+		if err := f(fields); err != nil {
+			return fmt.Errorf("%s:%d: %v", name, n, err)
+		}
+	}
+	return sc.Err()
+}
+
+// span is a range of characters sharing a property value.
+type span struct {
+	lo, hi rune
+	val    string
+}
+
+func parseRange(s string) (lo, hi rune, err error) {
+	parts := strings.SplitN(s, "..", 2)
+	n, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	lo, hi = rune(n), rune(n)
+	if len(parts) == 2 {
+		n, err = strconv.ParseUint(parts[1], 16, 32)
+		hi = rune(n)
+	}
+	return lo, hi, err
+}
+
+// spans reads a file mapping ranges of characters to property values, for
+// the property in the given field. Adjacent ranges with the same value are
+// merged.
+func (s *source) spans(name string, field int) ([]span, error) {
+	var sp []span
+	err := s.parse(name, func(f []string) error {
+		if len(f) <= field {
+			return fmt.Errorf("want at least %d fields", field+1)
+		}
+		lo, hi, err := parseRange(f[0])
+		if err != nil {
+			return err
+		}
+		sp = append(sp, span{lo, hi, f[field]})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sp, func(i, j int) bool { return sp[i].lo < sp[j].lo })
+	var out []span
+	for _, v := range sp {
+		if n := len(out); n > 0 && out[n-1].val == v.val && out[n-1].hi+1 == v.lo {
+			out[n-1].hi = v.hi
+			continue
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// sets reads a file listing binary properties, returning the characters
+// having each property.
+func (s *source) sets(name string) (map[string][]span, error) {
+	sp, err := s.spans(name, 1)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string][]span{}
+	for _, v := range sp {
+		m[v.val] = append(m[v.val], v)
+	}
+	return m, nil
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: go run genucd.go [-o dir] ucd-dir-or-zip")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := gen(flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func gen(path string) error {
+	s, err := openSource(path)
+	if err != nil {
+		return err
+	}
+	version, err := s.version()
+	if err != nil {
+		return err
+	}
+	if err := genData(s, version); err != nil {
+		return err
+	}
+	return genTables(s, version)
+}
+
+// genData writes the UnicodeData.txt records, encoded as tables in string
+// constants. All numbers in the tables are big-endian: 3-byte code points
+// and offsets, and 2-byte indexes.
+//
+// recRunes holds the code point of each record, in order. recData holds,
+// for each record, the index of its fields after the name in fieldSets,
+// then the indexes of the words of its name in words. fieldSets and words
+// hold distinct strings; words are sorted. recIndex, fieldIndex and
+// wordIndex hold the offsets of the items in those tables, plus a final
+// offset marking the end of the last item.
+func genData(s *source, version string) error {
+	type record struct {
+		r      rune
+		name   []string
+		fields string
+	}
+	var recs []record
+	fieldSets := map[string]int{}
+	var fieldList []string
+	words := map[string]int{}
+	err := s.parse("UnicodeData.txt", func(f []string) error {
+		if len(f) != 15 {
+			return fmt.Errorf("have %d fields, want 15", len(f))
+		}
+		r, hi, err := parseRange(f[0])
+		if err != nil || r != hi {
+			return fmt.Errorf("bad code point %q", f[0])
+		}
+		if n := len(recs); n > 0 && recs[n-1].r >= r {
+			return fmt.Errorf("%04X out of order", r)
+		}
+		rec := record{r, strings.Split(f[1], " "), strings.Join(f[2:], ";")}
+		if _, ok := fieldSets[rec.fields]; !ok {
+			fieldSets[rec.fields] = len(fieldList)
+			fieldList = append(fieldList, rec.fields)
+		}
+		for _, w := range rec.name {
+			if w == "" {
+				return fmt.Errorf("bad name %q", f[1])
+			}
+			words[w] = 0
+		}
+		recs = append(recs, rec)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	var wordList []string
+	for w := range words {
+		wordList = append(wordList, w)
+	}
+	sort.Strings(wordList)
+	for i, w := range wordList {
+		words[w] = i
+	}
+	if len(fieldList) > 1<<16 || len(wordList) > 1<<16 {
+		return fmt.Errorf("%d field sets and %d words overflow 2-byte indexes", len(fieldList), len(wordList))
+	}
+	// The name index numbers records in 2 bytes too; see names.go.
+	if len(recs) > 1<<16 {
+		return fmt.Errorf("%d records overflow the 2-byte record numbers of the name index", len(recs))
+	}
+
+	var runes, data, index []byte
+	for _, rec := range recs {
+		runes = put24(runes, int(rec.r))
+		index = put24(index, len(data))
+		data = put16(data, fieldSets[rec.fields])
+		for _, w := range rec.name {
+			data = put16(data, words[w])
+		}
+	}
+	index = put24(index, len(data))
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `// autogenerated by genucd - do not edit
+
+package ucd
+
+// Version is the version of Unicode this package provides data for.
+const Version = %q
+
+// Source is the source of UCD data this package provides access to.
+const Source = "https://www.unicode.org/Public/%s/ucd/"
+
+`, version, version)
+	writeString(&b, "recRunes", runes, false)
+	writeString(&b, "recIndex", index, false)
+	writeString(&b, "recData", data, false)
+	for _, t := range []struct {
+		name, index string
+		items       []string
+	}{{"fieldSets", "fieldIndex", fieldList}, {"words", "wordIndex", wordList}} {
+		var all, index []byte
+		for _, v := range t.items {
+			index = put24(index, len(all))
+			all = append(all, v...)
+		}
+		index = put24(index, len(all))
+		if len(all) >= 1<<24 {
+			return fmt.Errorf("%s overflows 3-byte offsets", t.name)
+		}
+		writeString(&b, t.name, all, true)
+		writeString(&b, t.index, index, false)
+	}
+	return write("ucd_data.go", b.Bytes())
+}
+
+func put16(b []byte, n int) []byte { return append(b, byte(n>>8), byte(n)) }
+func put24(b []byte, n int) []byte { return append(b, byte(n>>16), byte(n>>8), byte(n)) }
+
+// writeString writes a string constant holding data, over several lines.
+// Text is quoted legibly; other data is written in hexadecimal escapes.
+func writeString(b *bytes.Buffer, name string, data []byte, text bool) {
+	fmt.Fprintf(b, "const %s = \"\" +\n", name)
+	const width = 32
+	for len(data) > 0 {
+		n := width
+		if text {
+			n = 2 * width
+		}
+		if n > len(data) {
+			n = len(data)
+		}
+		if text {
+			fmt.Fprintf(b, "%s", strconv.Quote(string(data[:n])))
+		} else {
+			b.WriteByte('"')
+			for _, c := range data[:n] {
+				fmt.Fprintf(b, "\\x%02x", c)
+			}
+			b.WriteByte('"')
+		}
+		data = data[n:]
+		if len(data) > 0 {
+			b.WriteString(" +")
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+}
+
+// genTables writes the properties in the UCD files other than
+// UnicodeData.txt.
+func genTables(s *source, version string) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// autogenerated by genucd - do not edit\n\npackage ucd\n\nimport \"unicode\"\n\n")
+
+	for _, t := range []struct {
+		name, file, comment string
+		field               int
+	}{
+		{"blocks", "Blocks.txt", "blocks are the Unicode blocks.", 1},
+		{"scripts", "Scripts.txt", "scripts are the ranges of characters in each script.", 1},
+		{"eastAsianWidths", "EastAsianWidth.txt", "eastAsianWidths are the East_Asian_Width values of characters.", 1},
+		{"ages", "DerivedAge.txt", "ages are the versions of Unicode in which characters were assigned.", 1},
+	} {
+		sp, err := s.spans(t.file, t.field)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "// %s\nvar %s = []span{\n", t.comment, t.name)
+		for _, v := range sp {
+			fmt.Fprintf(&b, "{0x%04X, 0x%04X, %q},\n", v.lo, v.hi, v.val)
+		}
+		b.WriteString("}\n\n")
+	}
+
+	for _, t := range []struct {
+		name, file, comment string
+	}{
+		{"properties", "PropList.txt", "properties are the binary properties listed in PropList.txt."},
+		{"emojiProperties", "emoji-data.txt", "emojiProperties are the emoji properties of characters."},
+	} {
+		m, err := s.sets(t.file)
+		if err != nil {
+			return err
+		}
+		var names []string
+		for k := range m {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&b, "// %s\nvar %s = map[string]*unicode.RangeTable{\n", t.comment, t.name)
+		for _, k := range names {
+			fmt.Fprintf(&b, "%q: ", k)
+			rangeTable(&b, m[k])
+			b.WriteString(",\n")
+		}
+		b.WriteString("}\n\n")
+	}
+
+	b.WriteString("// aliases are the formal aliases of characters, as NameAliases.txt lists them.\nvar aliases = []alias{\n")
+	err := s.parse("NameAliases.txt", func(f []string) error {
+		if len(f) != 3 {
+			return fmt.Errorf("have %d fields, want 3", len(f))
+		}
+		r, _, err := parseRange(f[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "{0x%04X, %q, %q},\n", r, f[1], f[2])
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	b.WriteString("}\n")
+	return write("ucd_tables.go", b.Bytes())
+}
+
+// rangeTable writes a unicode.RangeTable literal with the given ranges.
+func rangeTable(b *bytes.Buffer, sp []span) {
+	var r16, r32 []span
+	for _, v := range sp {
+		switch {
+		case v.hi <= 0xFFFF:
+			r16 = append(r16, v)
+		case v.lo > 0xFFFF:
+			r32 = append(r32, v)
+		default:
+			r16 = append(r16, span{v.lo, 0xFFFF, ""})
+			r32 = append(r32, span{0x10000, v.hi, ""})
+		}
+	}
+	b.WriteString("&unicode.RangeTable{\n")
+	if len(r16) > 0 {
+		b.WriteString("R16: []unicode.Range16{\n")
+		for _, v := range r16 {
+			fmt.Fprintf(b, "{0x%04x, 0x%04x, 1},\n", v.lo, v.hi)
+		}
+		b.WriteString("},\n")
+	}
+	if len(r32) > 0 {
+		b.WriteString("R32: []unicode.Range32{\n")
+		for _, v := range r32 {
+			fmt.Fprintf(b, "{0x%x, 0x%x, 1},\n", v.lo, v.hi)
+		}
+		b.WriteString("},\n")
+	}
+	latin := 0
+	for _, v := range r16 {
+		if v.hi <= 0xFF {
+			latin++
+		}
+	}
+	if latin > 0 {
+		fmt.Fprintf(b, "LatinOffset: %d,\n", latin)
+	}
+	b.WriteString("}")
+}
+
+func write(name string, src []byte) error {
+	out, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return ioutil.WriteFile(filepath.Join(*outDir, name), out, 0644)
+}
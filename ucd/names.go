@@ -0,0 +1,337 @@
+package ucd
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The name index is built on first use: the words of the words table in
+// upper case, each on a line of wordText, starting at wordStarts; for each
+// word, the records with that word in their names, in order; the records of
+// the first characters of ranges, whose names are derived; the words of
+// aliases; and each name and alias, by its loose form. Records are numbered
+// in a uint16, which genucd checks is enough.
+var (
+	indexOnce   sync.Once
+	wordText    string
+	wordStarts  []int
+	postings    [][]uint16
+	rangeRecs   []int
+	aliasFields [][]string
+	looseNames  map[string]rune
+)
+
+func buildIndex() {
+	nwords := len(wordIndex)/3 - 1
+	var b strings.Builder
+	for w := 0; w < nwords; w++ {
+		wordStarts = append(wordStarts, b.Len())
+		b.WriteString(strings.ToUpper(item(words, wordIndex, w)))
+		b.WriteByte('\n')
+	}
+	wordText = b.String()
+	postings = make([][]uint16, nwords)
+	looseNames = make(map[string]rune, nrec)
+	for i := 0; i < nrec; i++ {
+		if rangeFirst(i) {
+			rangeRecs = append(rangeRecs, i)
+			i++ // past the record of the last character in the range.
+			continue
+		}
+		d := item(recData, recIndex, i)
+		for j := 2; j < len(d); j += 2 {
+			w := u16(d, j)
+			if p := postings[w]; len(p) == 0 || p[len(p)-1] != uint16(i) {
+				postings[w] = append(p, uint16(i))
+			}
+		}
+		if name := raw(i).Name(); name[0] != '<' {
+			looseNames[looseName(string(name))] = rawRune(i)
+		}
+	}
+	for s := NewScanner(0xAC00); !s.Done() && s.Record().Rune() <= 0xD7A3; s.Next() {
+		rec := s.Record()
+		looseNames[looseName(string(rec.Name()))] = rec.Rune()
+	}
+	for _, a := range aliases {
+		aliasFields = append(aliasFields, strings.Fields(a.name))
+		if k := looseName(a.name); looseNames[k] == 0 {
+			looseNames[k] = a.r
+		}
+	}
+}
+
+// wordsContaining returns the words that contain t, which has no newlines.
+func wordsContaining(t string) []int {
+	var ws []int
+	for off := 0; off < len(wordText); {
+		i := strings.Index(wordText[off:], t)
+		if i < 0 {
+			break
+		}
+		// The word the match is in, and on to the next.
+		w := sort.Search(len(wordStarts), func(j int) bool { return wordStarts[j] > off+i }) - 1
+		ws = append(ws, w)
+		off = len(wordText)
+		if w+1 < len(wordStarts) {
+			off = wordStarts[w+1]
+		}
+	}
+	return ws
+}
+
+// Alias is a formal alias of a character, as listed in NameAliases.txt.
+type Alias struct {
+	Name string
+	// Type is "correction", "control", "alternate", "figment" or
+	// "abbreviation".
+	Type string
+}
+
+// Aliases returns the formal aliases of the character, in the order the
+// UCD lists them.
+func (r Record) Aliases() []Alias {
+	c := r.Rune()
+	i := sort.Search(len(aliases), func(i int) bool { return aliases[i].r >= c })
+	var as []Alias
+	for ; i < len(aliases) && aliases[i].r == c; i++ {
+		as = append(as, Alias{aliases[i].name, aliases[i].typ})
+	}
+	return as
+}
+
+// DisplayName returns the name to show for the character. This is its
+// corrected name, if the misspelled or otherwise wrong Name has been
+// corrected. Characters without a Name, such as controls, are shown by their
+// first control name, figment or abbreviation. Other characters are shown
+// by their Name.
+func (r Record) DisplayName() []byte {
+	as := r.Aliases()
+	var correction []byte
+	for _, a := range as {
+		if a.Type == "correction" {
+			correction = []byte(a.Name) // the last correction stands.
+		}
+	}
+	if correction != nil {
+		return correction
+	}
+	if name := r.Name(); len(name) > 0 && name[0] != '<' {
+		return name
+	}
+	for _, typ := range []string{"control", "figment", "abbreviation"} {
+		for _, a := range as {
+			if a.Type == typ {
+				return []byte(a.Name)
+			}
+		}
+	}
+	return r.Name()
+}
+
+// looseName returns the form of a character name used for loose matching,
+// as UAX44-LM2 in http://www.unicode.org/reports/tr44/ specifies: in upper
+// case, without spaces, underscores or medial hyphens. The hyphen of
+// HANGUL JUNGSEONG O-E is kept, to tell it from HANGUL JUNGSEONG OE.
+func looseName(name string) string {
+	b := make([]byte, 0, len(name))
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '_':
+			continue
+		case c == '-' && i > 0 && i+1 < len(name) && !isSpace(name[i-1]) && !isSpace(name[i+1]):
+			continue
+		case 'a' <= c && c <= 'z':
+			c -= 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	if string(b) == "HANGULJUNGSEONGOE" && strings.Contains(strings.ToUpper(name), "O-E") {
+		return "HANGULJUNGSEONGO-E"
+	}
+	return string(b)
+}
+
+func isSpace(c byte) bool { return c == ' ' || c == '\t' || c == '\n' || c == '_' }
+
+// LookupName returns the Record of the character with the given name or
+// alias, or nil if there is none. Names match loosely, ignoring case, spaces,
+// underscores and most hyphens, so "latin_small_letter_a" finds
+// LATIN SMALL LETTER A, and "zwj" finds ZERO WIDTH JOINER.
+func LookupName(name string) Record {
+	indexOnce.Do(buildIndex)
+	key := looseName(name)
+	if r, ok := looseNames[key]; ok {
+		return Lookup(r)
+	}
+	// Names of ideographs and some other characters in ranges end in their
+	// code points.
+	for n := 4; n <= 6 && n < len(key); n++ {
+		r := parseRune([]byte(key[len(key)-n:]))
+		if r == 0 {
+			continue
+		}
+		if rec := Lookup(r); rec != nil && looseName(string(rec.Name())) == key {
+			return rec
+		}
+	}
+	return nil
+}
+
+// Search returns the characters whose names, or one of whose aliases, have
+// words containing each of the given terms, ignoring case. Terms may be in
+// any order and may be several words. The characters are returned in code
+// point order.
+func Search(terms ...string) []rune {
+	indexOnce.Do(buildIndex)
+	var ts []string
+	for _, t := range terms {
+		ts = append(ts, strings.Fields(strings.ToUpper(t))...)
+	}
+	if len(ts) == 0 {
+		return nil
+	}
+
+	// Intersect, term by term, the records with words containing the term.
+	var recs []uint16
+	for n, t := range ts {
+		var hits []uint16
+		for _, w := range wordsContaining(t) {
+			hits = append(hits, postings[w]...)
+		}
+		if n == 0 {
+			recs = union(hits)
+		} else {
+			recs = intersect(recs, union(hits))
+		}
+		if len(recs) == 0 {
+			break
+		}
+	}
+	var rs []rune
+	for _, i := range recs {
+		rs = append(rs, rawRune(int(i)))
+	}
+	for _, i := range rangeRecs {
+		rs = append(rs, searchRange(i, ts)...)
+	}
+	for i, a := range aliases {
+		if containsTerms(aliasFields[i], ts) {
+			rs = append(rs, a.r)
+		}
+	}
+	if len(rs) == 0 {
+		return nil
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i] < rs[j] })
+	out := rs[:1]
+	for _, r := range rs[1:] {
+		if r != out[len(out)-1] {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// union returns the distinct record numbers in a concatenation of postings,
+// in order.
+func union(p []uint16) []uint16 {
+	sort.Slice(p, func(i, j int) bool { return p[i] < p[j] })
+	var out []uint16
+	for _, i := range p {
+		if len(out) == 0 || out[len(out)-1] != i {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// intersect returns the record numbers in both a and b, which are in order.
+func intersect(a, b []uint16) []uint16 {
+	var out []uint16
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case a[0] < b[0]:
+			a = a[1:]
+		case a[0] > b[0]:
+			b = b[1:]
+		default:
+			out = append(out, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	return out
+}
+
+// searchRange returns the characters in the range starting at record i
+// whose names have words containing each of the terms. Names are only
+// derived character by character if some terms are not in the words that
+// all names in the range share.
+func searchRange(i int, terms []string) []rune {
+	label := rangeLabel(raw(i))
+	first, last := rawRune(i), rawRune(i+1)
+	shared := map[string]bool{}
+	for _, w := range strings.Fields(rangeName(label, first)) {
+		shared[w] = true
+	}
+	var common []string
+	for _, w := range strings.Fields(rangeName(label, last)) {
+		if shared[w] {
+			common = append(common, strings.ToUpper(w))
+		}
+	}
+	var rest []string
+	for _, t := range terms {
+		if !containsTerm(common, t) {
+			rest = append(rest, t)
+		}
+	}
+	if len(rest) > 0 && rangeName(label, first) == rangeName(label, last) {
+		return nil // all names in the range are the same.
+	}
+	// Only the last word of the names varies. Terms with letters it cannot
+	// have match no names.
+	var letters string
+	switch p := rangePrefix(label); {
+	case p != "":
+		letters = p[strings.LastIndexByte(p, ' ')+1:] + "0123456789ABCDEF"
+	case label == "Hangul Syllable":
+		letters = strings.Join(jamoL, "") + strings.Join(jamoV, "") + strings.Join(jamoT, "")
+	}
+	for _, t := range rest {
+		if letters != "" && strings.Trim(t, letters) != "" {
+			return nil
+		}
+	}
+	var rs []rune
+	for r := first; r <= last; r++ {
+		if len(rest) > 0 {
+			name := strings.Fields(strings.ToUpper(rangeName(label, r)))
+			if !containsTerms(name, rest) {
+				continue
+			}
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func containsTerm(words []string, t string) bool {
+	for _, w := range words {
+		if strings.Contains(w, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTerms(words, terms []string) bool {
+	for _, t := range terms {
+		if !containsTerm(words, t) {
+			return false
+		}
+	}
+	return true
+}
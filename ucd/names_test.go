@@ -0,0 +1,131 @@
+package ucd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookupName(t *testing.T) {
+	for _, d := range []struct {
+		name string
+		want rune
+	}{
+		{"GREEK SMALL LETTER LAMDA", 0x03BB},
+		{"greek small letter lamda", 0x03BB},
+		{"Greek_Small_Letter_Lamda", 0x03BB},
+		{"GreekSmallLetterLamda", 0x03BB},
+		{"SPACE", 0x0020},
+		{"LATIN SMALL LETTER A", 'a'},
+		{"ZERO WIDTH NO BREAK SPACE", 0xFEFF},
+		{"zero-width no-break space", 0xFEFF},
+		{"TIBETAN LETTER A", 0x0F68},
+		{"TIBETAN LETTER -A", 0x0F60},
+		{"HANGUL JUNGSEONG OE", 0x116C},
+		{"HANGUL JUNGSEONG O-E", 0x1180},
+		{"hangul jungseong o-e", 0x1180},
+		{"HANGUL SYLLABLE GAG", 0xAC01},
+		{"hangul syllable pwilh", 0xD4DB},
+		{"CJK UNIFIED IDEOGRAPH-4E2D", 0x4E2D},
+		{"cjk unified ideograph 20bb7", 0x20BB7},
+		{"CJK UNIFIED IDEOGRAPH-20000", 0x20000},
+		{"CJK UNIFIED IDEOGRAPH-30000", 0x30000},
+		{"TANGUT IDEOGRAPH-18D08", 0x18D08},
+		{"KHITAN SMALL SCRIPT CHARACTER-18B00", 0x18B00},
+		{"HEART HANDS", 0x1FAF6},
+		{"", -1},
+		{"<control>", -1},
+		{"NO SUCH CHARACTER", -1},
+		{"CJK UNIFIED IDEOGRAPH-A000", -1},
+		{"LATIN SMALL LETTER", -1},
+	} {
+		have := rune(-1)
+		if rec := LookupName(d.name); rec != nil {
+			have = rec.Rune()
+		}
+		if have != d.want {
+			t.Errorf("LookupName(%q)=%04X, want=%04X", d.name, have, d.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	for _, d := range []struct {
+		terms []string
+		want  []rune
+	}{
+		{[]string{"lamda", "greek", "small"}, []rune{0x03BB, 0x1D27}},
+		{[]string{"small capital lamda", "greek"}, []rune{0x1D27}},
+		{[]string{"fraktur", "math", "capital", "z"}, []rune{0x1D585}},
+		{[]string{"hangul", "syllable", "pwilh"}, []rune{0xD4DB}},
+		{[]string{"ideograph-4e2d"}, []rune{0x4E2D, 0x1F22D}},
+		{[]string{"bactrian"}, []rune{0x1F42B}},
+		{[]string{"nosuchword"}, nil},
+		{nil, nil},
+	} {
+		if have := Search(d.terms...); !reflect.DeepEqual(have, d.want) {
+			t.Errorf("Search(%q)=%U, want=%U", d.terms, have, d.want)
+		}
+	}
+	if n := len(Search("tagalog")); n != 23 {
+		t.Errorf("found %d Tagalog characters, want=23", n)
+	}
+}
+
+func BenchmarkLookupName(b *testing.B) {
+	names := []string{"GREEK SMALL LETTER LAMDA", "latin_small_letter_a", "CJK UNIFIED IDEOGRAPH-4E2D", "NO SUCH CHARACTER"}
+	for i := 0; i < b.N; i++ {
+		LookupName(names[i%len(names)])
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Search("math", "fraktur")
+	}
+}
+
+func TestAliases(t *testing.T) {
+	for _, d := range []struct {
+		r       rune
+		name    string
+		display string
+		aliases []Alias
+	}{
+		{0x000A, "<control>", "LINE FEED", []Alias{
+			{"LINE FEED", "control"}, {"NEW LINE", "control"}, {"END OF LINE", "control"},
+			{"LF", "abbreviation"}, {"NL", "abbreviation"}, {"EOL", "abbreviation"}}},
+		{0x0080, "<control>", "PADDING CHARACTER", []Alias{{"PADDING CHARACTER", "figment"}, {"PAD", "abbreviation"}}},
+		{0x01A2, "LATIN CAPITAL LETTER OI", "LATIN CAPITAL LETTER GHA", []Alias{{"LATIN CAPITAL LETTER GHA", "correction"}}},
+		{0xFEFF, "ZERO WIDTH NO-BREAK SPACE", "ZERO WIDTH NO-BREAK SPACE", []Alias{
+			{"BYTE ORDER MARK", "alternate"}, {"BOM", "abbreviation"}, {"ZWNBSP", "abbreviation"}}},
+		{'A', "LATIN CAPITAL LETTER A", "LATIN CAPITAL LETTER A", nil},
+	} {
+		rec := Lookup(d.r)
+		if name, display := string(rec.Name()), string(rec.DisplayName()); name != d.name || display != d.display {
+			t.Errorf("%04X: Name, DisplayName()=%q, %q, want=%q, %q", d.r, name, display, d.name, d.display)
+		}
+		if have := rec.Aliases(); !reflect.DeepEqual(have, d.aliases) {
+			t.Errorf("%04X: Aliases()=%q, want=%q", d.r, have, d.aliases)
+		}
+	}
+
+	for _, d := range []struct {
+		name string
+		want rune
+	}{
+		{"zwj", 0x200D},
+		{"line feed", 0x000A},
+		{"NBSP", 0x00A0},
+		{"LATIN CAPITAL LETTER GHA", 0x01A2},
+		{"LATIN CAPITAL LETTER OI", 0x01A2},
+		{"byte order mark", 0xFEFF},
+	} {
+		if rec := LookupName(d.name); rec == nil || rec.Rune() != d.want {
+			t.Errorf("LookupName(%q)=%q, want=%04X", d.name, rec, d.want)
+		}
+	}
+
+	if have, want := Search("zwj"), []rune{0x200D, 0x16B67}; !reflect.DeepEqual(have, want) {
+		t.Errorf("Search(zwj)=%U, want=%U", have, want)
+	}
+}
@@ -0,0 +1,166 @@
+package ucd
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// span is a range of characters sharing a property value.
+type span struct {
+	lo, hi rune
+	val    string
+}
+
+// alias is a formal alias of a character, as an Alias is.
+type alias struct {
+	r         rune
+	name, typ string
+}
+
+// spanValue returns the value of the span containing r, or def if there is
+// none. The spans must be sorted and must not overlap.
+func spanValue(sp []span, r rune, def string) string {
+	i := sort.Search(len(sp), func(i int) bool { return sp[i].hi >= r })
+	if i < len(sp) && sp[i].lo <= r {
+		return sp[i].val
+	}
+	return def
+}
+
+// Range is a named range of characters, such as a block.
+type Range struct {
+	First, Last rune
+	Name        string
+}
+
+// Block returns the name of the block the character is in, such as
+// "Basic Latin", or "No_Block".
+func (r Record) Block() string { return spanValue(blocks, r.Rune(), "No_Block") }
+
+// Script returns the name of the script of the character, such as "Latin".
+// Characters used with several scripts are "Common" or "Inherited", and
+// unassigned ones "Unknown".
+func (r Record) Script() string { return spanValue(scripts, r.Rune(), "Unknown") }
+
+// Blocks returns the Unicode blocks, in code point order.
+func Blocks() []Range { return ranges(blocks, "") }
+
+// LookupBlock returns the block with the given name, and whether there is
+// one. Names match loosely, ignoring case, spaces, underscores and hyphens.
+func LookupBlock(name string) (Range, bool) {
+	rs := ranges(blocks, name)
+	if len(rs) == 0 {
+		return Range{}, false
+	}
+	return rs[0], true
+}
+
+// Scripts returns the names of the scripts, sorted.
+func Scripts() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, s := range scripts {
+		if !seen[s.val] {
+			seen[s.val] = true
+			names = append(names, s.val)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ScriptRanges returns the ranges of characters in the script with the
+// given name, matched as LookupBlock matches names, or nil if there is no
+// such script.
+func ScriptRanges(name string) []Range { return ranges(scripts, name) }
+
+// ranges returns the spans with the given value, matched loosely, or all
+// spans if the value is "".
+func ranges(sp []span, val string) []Range {
+	key := looseValue(val)
+	var rs []Range
+	for _, s := range sp {
+		if val == "" || looseValue(s.val) == key {
+			rs = append(rs, Range{s.lo, s.hi, s.val})
+		}
+	}
+	return rs
+}
+
+// looseValue returns the form of a property value used for loose matching,
+// as UAX44-LM3 in http://www.unicode.org/reports/tr44/ specifies: in upper
+// case, without spaces, underscores or hyphens.
+func looseValue(v string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '_', '-':
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, v)
+}
+
+// EastAsianWidth returns the East_Asian_Width of the character: "W" for
+// wide, "F" for fullwidth, "H" for halfwidth, "Na" for narrow, "A" for
+// ambiguous or "N" for neutral.
+func (r Record) EastAsianWidth() string { return EastAsianWidth(r.Rune()) }
+
+// EastAsianWidth returns the East_Asian_Width of r, as Record.EastAsianWidth
+// does, without looking up its Record. r need not be assigned.
+func EastAsianWidth(r rune) string { return spanValue(eastAsianWidths, r, "N") }
+
+// Age returns the version of Unicode in which the character was assigned,
+// such as "1.1" or "14.0".
+func (r Record) Age() string { return spanValue(ages, r.Rune(), "") }
+
+// Properties returns the names of the binary properties the character has,
+// from PropList.txt and emoji-data.txt, sorted.
+func (r Record) Properties() []string {
+	c := r.Rune()
+	var names []string
+	for _, m := range []map[string]*unicode.RangeTable{properties, emojiProperties} {
+		for name, t := range m {
+			if unicode.Is(t, c) {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasProperty reports whether the character has the binary property with
+// the given name, such as "White_Space" or "Emoji", matched as LookupBlock
+// matches names.
+func (r Record) HasProperty(name string) bool {
+	t := property(name)
+	return t != nil && unicode.Is(t, r.Rune())
+}
+
+// PropertyNames returns the names of the binary properties that
+// HasProperty knows, sorted.
+func PropertyNames() []string {
+	var names []string
+	for _, m := range []map[string]*unicode.RangeTable{properties, emojiProperties} {
+		for name := range m {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// property returns the table of the binary property with the given name,
+// matched loosely, or nil if there is none.
+func property(name string) *unicode.RangeTable {
+	key := looseValue(name)
+	for _, m := range []map[string]*unicode.RangeTable{properties, emojiProperties} {
+		for n, t := range m {
+			if looseValue(n) == key {
+				return t
+			}
+		}
+	}
+	return nil
+}
@@ -2,100 +2,399 @@
 Package ucd provides access to the Unicode Character Databse.
 
 This package is intended for access to names and other information
-possibly not provided by package unicode in the standard library. It
-is not optimized for speed.
+possibly not provided by package unicode in the standard library. The data
+is compactly encoded: lookups take logarithmic time and scanning takes
+constant time per character.
+
+RawUCD, the text of UnicodeData.txt, is deprecated: the data is no longer
+stored as text, and RawUCD is built from it when the package is
+initialized.
 */
 package ucd
 
 import (
 	"bytes"
 	"fmt"
-	"regexp"
 	"sort"
 	"strconv"
+	"strings"
 )
 
+// The data files are generated from a local copy of the UCD, a directory or
+// zip file named by $UCD.
+//go:generate go run genucd.go $UCD
+
 // Record represents an entry in the UCD.
 type Record []byte
 
 // Rune returns the rune identifying this record.
-func (r Record) Rune() rune {
-	parts := bytes.SplitN(r, []byte(";"), 2)
-	if r, err := strconv.ParseInt(string(parts[0]), 16, 32); err == nil {
-		return rune(r)
+func (r Record) Rune() rune { return parseRune(r.field(0)) }
+
+// Name returns the Name field of a character record.
+func (r Record) Name() []byte { return r.field(1) }
+
+// Fields of a record, as numbered in http://www.unicode.org/reports/tr44/ .
+const (
+	fCategory = 2 + iota
+	fCombining
+	fBidi
+	fDecomposition
+	fDecimal
+	fDigit
+	fNumeric
+	fMirrored
+	fUnicode1Name
+	fISOComment
+	fUpper
+	fLower
+	fTitle
+)
+
+// field returns the i-th field of the record, or nil if there is none.
+func (r Record) field(i int) []byte {
+	for ; i > 0; i-- {
+		j := bytes.IndexByte(r, ';')
+		if j < 0 {
+			return nil
+		}
+		r = r[j+1:]
+	}
+	if j := bytes.IndexByte(r, ';'); j >= 0 {
+		return r[:j]
 	}
-	return 0
+	return r
 }
 
-// Name returns the Name field of a character record.
-func (r Record) Name() []byte {
-	parts := bytes.SplitN(r, []byte(";"), 3)
-	return parts[1]
+// Category returns the General_Category of the character, such as "Lu" for
+// an uppercase letter.
+func (r Record) Category() string { return string(r.field(fCategory)) }
+
+// CombiningClass returns the Canonical_Combining_Class of the character:
+// zero for characters that do not combine.
+func (r Record) CombiningClass() int {
+	n, _ := strconv.Atoi(string(r.field(fCombining)))
+	return n
 }
 
-// If needed, the other accessors are simple enough to add.
-// See http://www.unicode.org/reports/tr44/ .
+// BidiClass returns the Bidi_Class of the character, such as "L" for
+// left-to-right text.
+func (r Record) BidiClass() string { return string(r.field(fBidi)) }
+
+// Mirrored reports whether the character is mirrored in right-to-left text,
+// as parentheses are.
+func (r Record) Mirrored() bool { return string(r.field(fMirrored)) == "Y" }
+
+// Decomposition returns the decomposition type and mapping of the character.
+// The type is empty for canonical decompositions, and otherwise a tag such as
+// "compat" or "fraction". The mapping is nil if the character does not
+// decompose.
+func (r Record) Decomposition() (typ string, mapping []rune) {
+	f := bytes.Fields(r.field(fDecomposition))
+	if len(f) > 0 && f[0][0] == '<' {
+		typ = string(bytes.Trim(f[0], "<>"))
+		f = f[1:]
+	}
+	for _, v := range f {
+		mapping = append(mapping, parseRune(v))
+	}
+	return typ, mapping
+}
+
+// Decimal returns the value of the character as a decimal digit, and whether
+// it is one.
+func (r Record) Decimal() (int, bool) { return atoi(r.field(fDecimal)) }
+
+// Digit returns the value of the character as a digit, including digits
+// not used in decimal notation such as superscripts, and whether it is one.
+func (r Record) Digit() (int, bool) { return atoi(r.field(fDigit)) }
+
+// Numeric returns the numeric value of the character, which may be a
+// fraction, and whether it has one.
+func (r Record) Numeric() (float64, bool) {
+	f := r.field(fNumeric)
+	if len(f) == 0 {
+		return 0, false
+	}
+	parts := bytes.SplitN(f, []byte("/"), 2)
+	n, err := strconv.ParseFloat(string(parts[0]), 64)
+	if err != nil {
+		return 0, false
+	}
+	if len(parts) == 2 {
+		d, err := strconv.ParseFloat(string(parts[1]), 64)
+		if err != nil || d == 0 {
+			return 0, false
+		}
+		n /= d
+	}
+	return n, true
+}
+
+// Unicode1Name returns the name of the character in Unicode 1.0, if it was
+// different. This is mostly of interest for control characters, which have no
+// Name.
+func (r Record) Unicode1Name() []byte { return r.field(fUnicode1Name) }
+
+// Upper returns the simple uppercase mapping of the character: itself, if it
+// has none.
+func (r Record) Upper() rune { return r.mapping(fUpper) }
+
+// Lower returns the simple lowercase mapping of the character: itself, if it
+// has none.
+func (r Record) Lower() rune { return r.mapping(fLower) }
+
+// Title returns the simple titlecase mapping of the character: its uppercase
+// mapping, if it has none.
+func (r Record) Title() rune {
+	if len(r.field(fTitle)) == 0 {
+		return r.Upper()
+	}
+	return r.mapping(fTitle)
+}
+
+func (r Record) mapping(i int) rune {
+	f := r.field(i)
+	if len(f) == 0 {
+		return r.Rune()
+	}
+	return parseRune(f)
+}
+
+// parseRune parses a code point in hexadecimal, returning 0 if b is not
+// one.
+func parseRune(b []byte) rune {
+	if len(b) == 0 || len(b) > 6 {
+		return 0
+	}
+	var r rune
+	for _, c := range b {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'A' <= c && c <= 'F':
+			c -= 'A' - 10
+		case 'a' <= c && c <= 'f':
+			c -= 'a' - 10
+		default:
+			return 0
+		}
+		r = r<<4 | rune(c)
+	}
+	return r
+}
+
+func atoi(b []byte) (int, bool) {
+	n, err := strconv.Atoi(string(b))
+	return n, err == nil
+}
 
 // Lookup searches for r in the UCD. It returns the matching Record or nil if not found.
 //
-// Searches take logarithmic time and are not currently cached.
+// Characters in ranges the UCD lists by their first and last characters,
+// such as CJK ideographs, get records of their own, with their names derived
+// as the Unicode Standard specifies, such as CJK UNIFIED IDEOGRAPH-4E2D.
+// Ranges of characters without names, such as private use characters, are
+// named by their range, such as <Private Use>, as <control> characters are.
+//
+// Searches take logarithmic time.
 func Lookup(r rune) Record {
-	rec, _ := search(r)
+	i := search(r)
+	if i == nrec {
+		return nil
+	}
+	rec := raw(i)
+	switch {
+	case rec.Rune() != r && !isRangeLast(rec):
+		return nil
+	case isRangeFirst(rec) || isRangeLast(rec):
+		return inRange(rec, r)
+	}
 	return rec
 }
 
+// rangeLabel returns the label of a record marking the first or last
+// character of a range, such as "CJK Ideograph" for
+// "<CJK Ideograph, First>", or "" for other records.
+func rangeLabel(rec Record) string {
+	name := rec.Name()
+	for _, suf := range []string{", First>", ", Last>"} {
+		if len(name) > 0 && name[0] == '<' && bytes.HasSuffix(name, []byte(suf)) {
+			return string(name[1 : len(name)-len(suf)])
+		}
+	}
+	return ""
+}
+
+func isRangeFirst(rec Record) bool { return bytes.HasSuffix(rec.Name(), []byte(", First>")) }
+func isRangeLast(rec Record) bool  { return bytes.HasSuffix(rec.Name(), []byte(", Last>")) }
+
+// inRange returns the record of r, which is in the range that rec marks the
+// first or last character of.
+func inRange(rec Record, r rune) Record {
+	parts := bytes.SplitN(rec, []byte(";"), 3)
+	var out []byte
+	out = append(out, fmt.Sprintf("%04X;%s;", r, rangeName(rangeLabel(rec), r))...)
+	return append(out, parts[2]...)
+}
+
+// rangePrefix returns the prefix of the names of characters in the range
+// with the given label, for ranges of characters named by a prefix and their
+// code point, or "" for other ranges.
+func rangePrefix(label string) string {
+	for _, p := range []struct{ label, prefix string }{
+		{"CJK Ideograph", "CJK UNIFIED IDEOGRAPH-"},
+		{"Tangut Ideograph", "TANGUT IDEOGRAPH-"},
+		{"Khitan Small Script", "KHITAN SMALL SCRIPT CHARACTER-"},
+		{"Nushu Character", "NUSHU CHARACTER-"},
+	} {
+		if strings.HasPrefix(label, p.label) {
+			return p.prefix
+		}
+	}
+	return ""
+}
+
+// rangeName returns the name of r, in the range with the given label.
+func rangeName(label string, r rune) string {
+	if p := rangePrefix(label); p != "" {
+		return fmt.Sprintf("%s%04X", p, r)
+	}
+	if label == "Hangul Syllable" {
+		return hangulName(r)
+	}
+	return "<" + label + ">"
+}
+
+// Jamo short names, for deriving the names of Hangul syllables.
 var (
-	searchRE = regexp.MustCompile(`\n(([\dA-F]+);([^;]*);.*)\n`)
+	jamoL = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	jamoV = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	jamoT = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
 )
 
-func compare(a, b []byte) int {
-	if len(a) < len(b) {
-		return -1
+// hangulName returns the name of a precomposed Hangul syllable, as section
+// 3.12 of the Unicode Standard specifies.
+func hangulName(r rune) string {
+	const (
+		sBase  = 0xAC00
+		tCount = 28
+		nCount = 21 * tCount
+	)
+	s := int(r - sBase)
+	return "HANGUL SYLLABLE " + jamoL[s/nCount] + jamoV[s%nCount/tCount] + jamoT[s%tCount]
+}
+
+// The UCD records are encoded in tables that genucd.go describes.
+
+// nrec is the number of UCD records.
+var nrec = len(recRunes) / 3
+
+func u16(s string, i int) int { return int(s[i])<<8 | int(s[i+1]) }
+func u24(s string, i int) int { return int(s[i])<<16 | int(s[i+1])<<8 | int(s[i+2]) }
+
+// item returns the i-th item of a table of strings, given its index of
+// offsets.
+func item(table, index string, i int) string {
+	return table[u24(index, 3*i):u24(index, 3*i+3)]
+}
+
+// rawRune returns the code point of the i-th record.
+func rawRune(i int) rune { return rune(u24(recRunes, 3*i)) }
+
+// raw returns the i-th record, as UnicodeData.txt has it.
+func raw(i int) Record { return appendRaw(make(Record, 0, rawLen(i)), i) }
+
+// rawLen returns at least the length of the i-th record.
+func rawLen(i int) int {
+	d := item(recData, recIndex, i)
+	n := 6 + 1 + len(item(fieldSets, fieldIndex, u16(d, 0)))
+	for j := 2; j < len(d); j += 2 {
+		n += 1 + len(item(words, wordIndex, u16(d, j)))
 	}
-	if len(a) > len(b) {
-		return 1
+	return n
+}
+
+// appendRaw appends the i-th record, as UnicodeData.txt has it, to b.
+func appendRaw(b []byte, i int) []byte {
+	d := item(recData, recIndex, i)
+	b = appendHex(b, rawRune(i))
+	sep := byte(';')
+	for j := 2; j < len(d); j += 2 {
+		b = append(append(b, sep), item(words, wordIndex, u16(d, j))...)
+		sep = ' '
 	}
-	return bytes.Compare(a, b)
+	return append(append(b, ';'), item(fieldSets, fieldIndex, u16(d, 0))...)
 }
 
-// search searches for r in the UCD.
-// It returns a Record and position. (Position always points to the newline
-// at the start of a record.) If not found, returns nil and -1.
-func search(r rune) (Record, int) {
-	key := []byte(fmt.Sprintf("%04X", r))
-	n := sort.Search(maxRaw, func(i int) bool {
-		wind := rawUCD[i : i+pad]
-		m := searchRE.FindSubmatch(wind)
-		if m == nil {
-			return true
-		}
-		return compare(m[2], key) >= 0
-	})
-	wind := rawUCD[n : n+pad]
-	if m := searchRE.FindSubmatchIndex(wind); m != nil {
-		// With the sort.Search API, we got a guess at n, but
-		// still need to compare it to our desired key.
-		if bytes.Equal(rawUCD[n+m[4]:n+m[5]], key) {
-			return rawUCD[n+m[2] : n+m[3]], n + m[0]
-		}
+// RawUCD holds the records of UnicodeData.txt, each preceded by a newline,
+// with a newline after the last. Ranges are given by their first and last
+// records, as in UnicodeData.txt. It must not be modified.
+//
+// Deprecated: Use Lookup and Scanner, which do not need the text of all
+// records.
+var RawUCD = rawUCD()
+
+// rawUCD returns the text of RawUCD.
+func rawUCD() []byte {
+	n := 1
+	for i := 0; i < nrec; i++ {
+		n += rawLen(i) + 1
+	}
+	b := append(make([]byte, 0, n), '\n')
+	for i := 0; i < nrec; i++ {
+		b = append(appendRaw(b, i), '\n')
 	}
-	return nil, -1
+	return b
+}
+
+// appendHex appends r in hexadecimal, with at least four digits.
+func appendHex(b []byte, r rune) []byte {
+	const digits = "0123456789ABCDEF"
+	var buf [6]byte
+	i := len(buf)
+	for n := 0; n < 4 || r > 0; n++ {
+		i--
+		buf[i] = digits[r&0xF]
+		r >>= 4
+	}
+	return append(b, buf[i:]...)
+}
+
+// rangeFirst reports whether the i-th record marks the first character of a
+// range, without decoding it.
+func rangeFirst(i int) bool {
+	d := item(recData, recIndex, i)
+	return item(words, wordIndex, u16(d, len(d)-2)) == "First>"
+}
+
+// search returns the index of the first record at or after r, or nrec if
+// there is none.
+func search(r rune) int {
+	return sort.Search(nrec, func(i int) bool { return rawRune(i) >= r })
 }
 
 // Scanner is an iterator over the UCD. A zero Scanner is ready to use
-// and scans from the first character.
+// and scans from the first character. Characters in ranges are scanned one
+// by one, as Lookup would return them.
 type Scanner struct {
-	pos  int // Always points to newline at raw record start.
+	i    int  // Index of the current record.
+	off  rune // Offset of the current character from the start of a range.
 	done bool
 }
 
 // NewScanner returns a Scanner that starts scanning from the given rune.
 func NewScanner(start rune) *Scanner {
-	rec, pos := search(start)
-	if rec == nil {
+	i := search(start)
+	switch {
+	case i == nrec:
+		return &Scanner{done: true}
+	case i > 0 && rangeFirst(i-1):
+		// Start from the record of the first character in the range.
+		return &Scanner{i: i - 1, off: start - rawRune(i-1)}
+	case rawRune(i) != start:
 		return &Scanner{done: true}
 	}
-	return &Scanner{pos: pos}
+	return &Scanner{i: i}
 }
 
 // Done reports whether scanning has ended. Once this returns true, Record
@@ -104,14 +403,16 @@ func (s Scanner) Done() bool { return s.done }
 
 // Next advances the iterator.
 func (s *Scanner) Next() {
-	i := bytes.Index(rawUCD[s.pos+1:], []byte("\n"))
-	if i < 0 {
-		s.done = true
-	}
-	s.pos += i + 1
-	if s.pos >= maxRaw-1 {
-		s.done = true
+	if rangeFirst(s.i) {
+		if rawRune(s.i)+s.off < rawRune(s.i+1) {
+			s.off++
+			return
+		}
+		s.i++ // to the record of the last character in the range.
+		s.off = 0
 	}
+	s.i++
+	s.done = s.i >= nrec
 }
 
 // Record returns the current UCD record. It is a fatal error to call this
@@ -120,6 +421,9 @@ func (s Scanner) Record() Record {
 	if s.done {
 		panic("Record called after Done()=true")
 	}
-	end := bytes.Index(rawUCD[s.pos+1:], []byte("\n"))
-	return rawUCD[s.pos+1 : s.pos+1+end]
+	rec := raw(s.i)
+	if rangeFirst(s.i) {
+		return inRange(rec, rec.Rune()+s.off)
+	}
+	return rec
 }
@@ -0,0 +1,5400 @@
+// autogenerated by genucd - do not edit
+
+package ucd
+
+import "unicode"
+
+// blocks are the Unicode blocks.
+var blocks = []span{
+	{0x0000, 0x007F, "Basic Latin"},
+	{0x0080, 0x00FF, "Latin-1 Supplement"},
+	{0x0100, 0x017F, "Latin Extended-A"},
+	{0x0180, 0x024F, "Latin Extended-B"},
+	{0x0250, 0x02AF, "IPA Extensions"},
+	{0x02B0, 0x02FF, "Spacing Modifier Letters"},
+	{0x0300, 0x036F, "Combining Diacritical Marks"},
+	{0x0370, 0x03FF, "Greek and Coptic"},
+	{0x0400, 0x04FF, "Cyrillic"},
+	{0x0500, 0x052F, "Cyrillic Supplement"},
+	{0x0530, 0x058F, "Armenian"},
+	{0x0590, 0x05FF, "Hebrew"},
+	{0x0600, 0x06FF, "Arabic"},
+	{0x0700, 0x074F, "Syriac"},
+	{0x0750, 0x077F, "Arabic Supplement"},
+	{0x0780, 0x07BF, "Thaana"},
+	{0x07C0, 0x07FF, "NKo"},
+	{0x0800, 0x083F, "Samaritan"},
+	{0x0840, 0x085F, "Mandaic"},
+	{0x0860, 0x086F, "Syriac Supplement"},
+	{0x0870, 0x089F, "Arabic Extended-B"},
+	{0x08A0, 0x08FF, "Arabic Extended-A"},
+	{0x0900, 0x097F, "Devanagari"},
+	{0x0980, 0x09FF, "Bengali"},
+	{0x0A00, 0x0A7F, "Gurmukhi"},
+	{0x0A80, 0x0AFF, "Gujarati"},
+	{0x0B00, 0x0B7F, "Oriya"},
+	{0x0B80, 0x0BFF, "Tamil"},
+	{0x0C00, 0x0C7F, "Telugu"},
+	{0x0C80, 0x0CFF, "Kannada"},
+	{0x0D00, 0x0D7F, "Malayalam"},
+	{0x0D80, 0x0DFF, "Sinhala"},
+	{0x0E00, 0x0E7F, "Thai"},
+	{0x0E80, 0x0EFF, "Lao"},
+	{0x0F00, 0x0FFF, "Tibetan"},
+	{0x1000, 0x109F, "Myanmar"},
+	{0x10A0, 0x10FF, "Georgian"},
+	{0x1100, 0x11FF, "Hangul Jamo"},
+	{0x1200, 0x137F, "Ethiopic"},
+	{0x1380, 0x139F, "Ethiopic Supplement"},
+	{0x13A0, 0x13FF, "Cherokee"},
+	{0x1400, 0x167F, "Unified Canadian Aboriginal Syllabics"},
+	{0x1680, 0x169F, "Ogham"},
+	{0x16A0, 0x16FF, "Runic"},
+	{0x1700, 0x171F, "Tagalog"},
+	{0x1720, 0x173F, "Hanunoo"},
+	{0x1740, 0x175F, "Buhid"},
+	{0x1760, 0x177F, "Tagbanwa"},
+	{0x1780, 0x17FF, "Khmer"},
+	{0x1800, 0x18AF, "Mongolian"},
+	{0x18B0, 0x18FF, "Unified Canadian Aboriginal Syllabics Extended"},
+	{0x1900, 0x194F, "Limbu"},
+	{0x1950, 0x197F, "Tai Le"},
+	{0x1980, 0x19DF, "New Tai Lue"},
+	{0x19E0, 0x19FF, "Khmer Symbols"},
+	{0x1A00, 0x1A1F, "Buginese"},
+	{0x1A20, 0x1AAF, "Tai Tham"},
+	{0x1AB0, 0x1AFF, "Combining Diacritical Marks Extended"},
+	{0x1B00, 0x1B7F, "Balinese"},
+	{0x1B80, 0x1BBF, "Sundanese"},
+	{0x1BC0, 0x1BFF, "Batak"},
+	{0x1C00, 0x1C4F, "Lepcha"},
+	{0x1C50, 0x1C7F, "Ol Chiki"},
+	{0x1C80, 0x1C8F, "Cyrillic Extended-C"},
+	{0x1C90, 0x1CBF, "Georgian Extended"},
+	{0x1CC0, 0x1CCF, "Sundanese Supplement"},
+	{0x1CD0, 0x1CFF, "Vedic Extensions"},
+	{0x1D00, 0x1D7F, "Phonetic Extensions"},
+	{0x1D80, 0x1DBF, "Phonetic Extensions Supplement"},
+	{0x1DC0, 0x1DFF, "Combining Diacritical Marks Supplement"},
+	{0x1E00, 0x1EFF, "Latin Extended Additional"},
+	{0x1F00, 0x1FFF, "Greek Extended"},
+	{0x2000, 0x206F, "General Punctuation"},
+	{0x2070, 0x209F, "Superscripts and Subscripts"},
+	{0x20A0, 0x20CF, "Currency Symbols"},
+	{0x20D0, 0x20FF, "Combining Diacritical Marks for Symbols"},
+	{0x2100, 0x214F, "Letterlike Symbols"},
+	{0x2150, 0x218F, "Number Forms"},
+	{0x2190, 0x21FF, "Arrows"},
+	{0x2200, 0x22FF, "Mathematical Operators"},
+	{0x2300, 0x23FF, "Miscellaneous Technical"},
+	{0x2400, 0x243F, "Control Pictures"},
+	{0x2440, 0x245F, "Optical Character Recognition"},
+	{0x2460, 0x24FF, "Enclosed Alphanumerics"},
+	{0x2500, 0x257F, "Box Drawing"},
+	{0x2580, 0x259F, "Block Elements"},
+	{0x25A0, 0x25FF, "Geometric Shapes"},
+	{0x2600, 0x26FF, "Miscellaneous Symbols"},
+	{0x2700, 0x27BF, "Dingbats"},
+	{0x27C0, 0x27EF, "Miscellaneous Mathematical Symbols-A"},
+	{0x27F0, 0x27FF, "Supplemental Arrows-A"},
+	{0x2800, 0x28FF, "Braille Patterns"},
+	{0x2900, 0x297F, "Supplemental Arrows-B"},
+	{0x2980, 0x29FF, "Miscellaneous Mathematical Symbols-B"},
+	{0x2A00, 0x2AFF, "Supplemental Mathematical Operators"},
+	{0x2B00, 0x2BFF, "Miscellaneous Symbols and Arrows"},
+	{0x2C00, 0x2C5F, "Glagolitic"},
+	{0x2C60, 0x2C7F, "Latin Extended-C"},
+	{0x2C80, 0x2CFF, "Coptic"},
+	{0x2D00, 0x2D2F, "Georgian Supplement"},
+	{0x2D30, 0x2D7F, "Tifinagh"},
+	{0x2D80, 0x2DDF, "Ethiopic Extended"},
+	{0x2DE0, 0x2DFF, "Cyrillic Extended-A"},
+	{0x2E00, 0x2E7F, "Supplemental Punctuation"},
+	{0x2E80, 0x2EFF, "CJK Radicals Supplement"},
+	{0x2F00, 0x2FDF, "Kangxi Radicals"},
+	{0x2FF0, 0x2FFF, "Ideographic Description Characters"},
+	{0x3000, 0x303F, "CJK Symbols and Punctuation"},
+	{0x3040, 0x309F, "Hiragana"},
+	{0x30A0, 0x30FF, "Katakana"},
+	{0x3100, 0x312F, "Bopomofo"},
+	{0x3130, 0x318F, "Hangul Compatibility Jamo"},
+	{0x3190, 0x319F, "Kanbun"},
+	{0x31A0, 0x31BF, "Bopomofo Extended"},
+	{0x31C0, 0x31EF, "CJK Strokes"},
+	{0x31F0, 0x31FF, "Katakana Phonetic Extensions"},
+	{0x3200, 0x32FF, "Enclosed CJK Letters and Months"},
+	{0x3300, 0x33FF, "CJK Compatibility"},
+	{0x3400, 0x4DBF, "CJK Unified Ideographs Extension A"},
+	{0x4DC0, 0x4DFF, "Yijing Hexagram Symbols"},
+	{0x4E00, 0x9FFF, "CJK Unified Ideographs"},
+	{0xA000, 0xA48F, "Yi Syllables"},
+	{0xA490, 0xA4CF, "Yi Radicals"},
+	{0xA4D0, 0xA4FF, "Lisu"},
+	{0xA500, 0xA63F, "Vai"},
+	{0xA640, 0xA69F, "Cyrillic Extended-B"},
+	{0xA6A0, 0xA6FF, "Bamum"},
+	{0xA700, 0xA71F, "Modifier Tone Letters"},
+	{0xA720, 0xA7FF, "Latin Extended-D"},
+	{0xA800, 0xA82F, "Syloti Nagri"},
+	{0xA830, 0xA83F, "Common Indic Number Forms"},
+	{0xA840, 0xA87F, "Phags-pa"},
+	{0xA880, 0xA8DF, "Saurashtra"},
+	{0xA8E0, 0xA8FF, "Devanagari Extended"},
+	{0xA900, 0xA92F, "Kayah Li"},
+	{0xA930, 0xA95F, "Rejang"},
+	{0xA960, 0xA97F, "Hangul Jamo Extended-A"},
+	{0xA980, 0xA9DF, "Javanese"},
+	{0xA9E0, 0xA9FF, "Myanmar Extended-B"},
+	{0xAA00, 0xAA5F, "Cham"},
+	{0xAA60, 0xAA7F, "Myanmar Extended-A"},
+	{0xAA80, 0xAADF, "Tai Viet"},
+	{0xAAE0, 0xAAFF, "Meetei Mayek Extensions"},
+	{0xAB00, 0xAB2F, "Ethiopic Extended-A"},
+	{0xAB30, 0xAB6F, "Latin Extended-E"},
+	{0xAB70, 0xABBF, "Cherokee Supplement"},
+	{0xABC0, 0xABFF, "Meetei Mayek"},
+	{0xAC00, 0xD7AF, "Hangul Syllables"},
+	{0xD7B0, 0xD7FF, "Hangul Jamo Extended-B"},
+	{0xD800, 0xDB7F, "High Surrogates"},
+	{0xDB80, 0xDBFF, "High Private Use Surrogates"},
+	{0xDC00, 0xDFFF, "Low Surrogates"},
+	{0xE000, 0xF8FF, "Private Use Area"},
+	{0xF900, 0xFAFF, "CJK Compatibility Ideographs"},
+	{0xFB00, 0xFB4F, "Alphabetic Presentation Forms"},
+	{0xFB50, 0xFDFF, "Arabic Presentation Forms-A"},
+	{0xFE00, 0xFE0F, "Variation Selectors"},
+	{0xFE10, 0xFE1F, "Vertical Forms"},
+	{0xFE20, 0xFE2F, "Combining Half Marks"},
+	{0xFE30, 0xFE4F, "CJK Compatibility Forms"},
+	{0xFE50, 0xFE6F, "Small Form Variants"},
+	{0xFE70, 0xFEFF, "Arabic Presentation Forms-B"},
+	{0xFF00, 0xFFEF, "Halfwidth and Fullwidth Forms"},
+	{0xFFF0, 0xFFFF, "Specials"},
+	{0x10000, 0x1007F, "Linear B Syllabary"},
+	{0x10080, 0x100FF, "Linear B Ideograms"},
+	{0x10100, 0x1013F, "Aegean Numbers"},
+	{0x10140, 0x1018F, "Ancient Greek Numbers"},
+	{0x10190, 0x101CF, "Ancient Symbols"},
+	{0x101D0, 0x101FF, "Phaistos Disc"},
+	{0x10280, 0x1029F, "Lycian"},
+	{0x102A0, 0x102DF, "Carian"},
+	{0x102E0, 0x102FF, "Coptic Epact Numbers"},
+	{0x10300, 0x1032F, "Old Italic"},
+	{0x10330, 0x1034F, "Gothic"},
+	{0x10350, 0x1037F, "Old Permic"},
+	{0x10380, 0x1039F, "Ugaritic"},
+	{0x103A0, 0x103DF, "Old Persian"},
+	{0x10400, 0x1044F, "Deseret"},
+	{0x10450, 0x1047F, "Shavian"},
+	{0x10480, 0x104AF, "Osmanya"},
+	{0x104B0, 0x104FF, "Osage"},
+	{0x10500, 0x1052F, "Elbasan"},
+	{0x10530, 0x1056F, "Caucasian Albanian"},
+	{0x10570, 0x105BF, "Vithkuqi"},
+	{0x10600, 0x1077F, "Linear A"},
+	{0x10780, 0x107BF, "Latin Extended-F"},
+	{0x10800, 0x1083F, "Cypriot Syllabary"},
+	{0x10840, 0x1085F, "Imperial Aramaic"},
+	{0x10860, 0x1087F, "Palmyrene"},
+	{0x10880, 0x108AF, "Nabataean"},
+	{0x108E0, 0x108FF, "Hatran"},
+	{0x10900, 0x1091F, "Phoenician"},
+	{0x10920, 0x1093F, "Lydian"},
+	{0x10980, 0x1099F, "Meroitic Hieroglyphs"},
+	{0x109A0, 0x109FF, "Meroitic Cursive"},
+	{0x10A00, 0x10A5F, "Kharoshthi"},
+	{0x10A60, 0x10A7F, "Old South Arabian"},
+	{0x10A80, 0x10A9F, "Old North Arabian"},
+	{0x10AC0, 0x10AFF, "Manichaean"},
+	{0x10B00, 0x10B3F, "Avestan"},
+	{0x10B40, 0x10B5F, "Inscriptional Parthian"},
+	{0x10B60, 0x10B7F, "Inscriptional Pahlavi"},
+	{0x10B80, 0x10BAF, "Psalter Pahlavi"},
+	{0x10C00, 0x10C4F, "Old Turkic"},
+	{0x10C80, 0x10CFF, "Old Hungarian"},
+	{0x10D00, 0x10D3F, "Hanifi Rohingya"},
+	{0x10E60, 0x10E7F, "Rumi Numeral Symbols"},
+	{0x10E80, 0x10EBF, "Yezidi"},
+	{0x10F00, 0x10F2F, "Old Sogdian"},
+	{0x10F30, 0x10F6F, "Sogdian"},
+	{0x10F70, 0x10FAF, "Old Uyghur"},
+	{0x10FB0, 0x10FDF, "Chorasmian"},
+	{0x10FE0, 0x10FFF, "Elymaic"},
+	{0x11000, 0x1107F, "Brahmi"},
+	{0x11080, 0x110CF, "Kaithi"},
+	{0x110D0, 0x110FF, "Sora Sompeng"},
+	{0x11100, 0x1114F, "Chakma"},
+	{0x11150, 0x1117F, "Mahajani"},
+	{0x11180, 0x111DF, "Sharada"},
+	{0x111E0, 0x111FF, "Sinhala Archaic Numbers"},
+	{0x11200, 0x1124F, "Khojki"},
+	{0x11280, 0x112AF, "Multani"},
+	{0x112B0, 0x112FF, "Khudawadi"},
+	{0x11300, 0x1137F, "Grantha"},
+	{0x11400, 0x1147F, "Newa"},
+	{0x11480, 0x114DF, "Tirhuta"},
+	{0x11580, 0x115FF, "Siddham"},
+	{0x11600, 0x1165F, "Modi"},
+	{0x11660, 0x1167F, "Mongolian Supplement"},
+	{0x11680, 0x116CF, "Takri"},
+	{0x11700, 0x1174F, "Ahom"},
+	{0x11800, 0x1184F, "Dogra"},
+	{0x118A0, 0x118FF, "Warang Citi"},
+	{0x11900, 0x1195F, "Dives Akuru"},
+	{0x119A0, 0x119FF, "Nandinagari"},
+	{0x11A00, 0x11A4F, "Zanabazar Square"},
+	{0x11A50, 0x11AAF, "Soyombo"},
+	{0x11AB0, 0x11ABF, "Unified Canadian Aboriginal Syllabics Extended-A"},
+	{0x11AC0, 0x11AFF, "Pau Cin Hau"},
+	{0x11C00, 0x11C6F, "Bhaiksuki"},
+	{0x11C70, 0x11CBF, "Marchen"},
+	{0x11D00, 0x11D5F, "Masaram Gondi"},
+	{0x11D60, 0x11DAF, "Gunjala Gondi"},
+	{0x11EE0, 0x11EFF, "Makasar"},
+	{0x11FB0, 0x11FBF, "Lisu Supplement"},
+	{0x11FC0, 0x11FFF, "Tamil Supplement"},
+	{0x12000, 0x123FF, "Cuneiform"},
+	{0x12400, 0x1247F, "Cuneiform Numbers and Punctuation"},
+	{0x12480, 0x1254F, "Early Dynastic Cuneiform"},
+	{0x12F90, 0x12FFF, "Cypro-Minoan"},
+	{0x13000, 0x1342F, "Egyptian Hieroglyphs"},
+	{0x13430, 0x1343F, "Egyptian Hieroglyph Format Controls"},
+	{0x14400, 0x1467F, "Anatolian Hieroglyphs"},
+	{0x16800, 0x16A3F, "Bamum Supplement"},
+	{0x16A40, 0x16A6F, "Mro"},
+	{0x16A70, 0x16ACF, "Tangsa"},
+	{0x16AD0, 0x16AFF, "Bassa Vah"},
+	{0x16B00, 0x16B8F, "Pahawh Hmong"},
+	{0x16E40, 0x16E9F, "Medefaidrin"},
+	{0x16F00, 0x16F9F, "Miao"},
+	{0x16FE0, 0x16FFF, "Ideographic Symbols and Punctuation"},
+	{0x17000, 0x187FF, "Tangut"},
+	{0x18800, 0x18AFF, "Tangut Components"},
+	{0x18B00, 0x18CFF, "Khitan Small Script"},
+	{0x18D00, 0x18D7F, "Tangut Supplement"},
+	{0x1AFF0, 0x1AFFF, "Kana Extended-B"},
+	{0x1B000, 0x1B0FF, "Kana Supplement"},
+	{0x1B100, 0x1B12F, "Kana Extended-A"},
+	{0x1B130, 0x1B16F, "Small Kana Extension"},
+	{0x1B170, 0x1B2FF, "Nushu"},
+	{0x1BC00, 0x1BC9F, "Duployan"},
+	{0x1BCA0, 0x1BCAF, "Shorthand Format Controls"},
+	{0x1CF00, 0x1CFCF, "Znamenny Musical Notation"},
+	{0x1D000, 0x1D0FF, "Byzantine Musical Symbols"},
+	{0x1D100, 0x1D1FF, "Musical Symbols"},
+	{0x1D200, 0x1D24F, "Ancient Greek Musical Notation"},
+	{0x1D2E0, 0x1D2FF, "Mayan Numerals"},
+	{0x1D300, 0x1D35F, "Tai Xuan Jing Symbols"},
+	{0x1D360, 0x1D37F, "Counting Rod Numerals"},
+	{0x1D400, 0x1D7FF, "Mathematical Alphanumeric Symbols"},
+	{0x1D800, 0x1DAAF, "Sutton SignWriting"},
+	{0x1DF00, 0x1DFFF, "Latin Extended-G"},
+	{0x1E000, 0x1E02F, "Glagolitic Supplement"},
+	{0x1E100, 0x1E14F, "Nyiakeng Puachue Hmong"},
+	{0x1E290, 0x1E2BF, "Toto"},
+	{0x1E2C0, 0x1E2FF, "Wancho"},
+	{0x1E7E0, 0x1E7FF, "Ethiopic Extended-B"},
+	{0x1E800, 0x1E8DF, "Mende Kikakui"},
+	{0x1E900, 0x1E95F, "Adlam"},
+	{0x1EC70, 0x1ECBF, "Indic Siyaq Numbers"},
+	{0x1ED00, 0x1ED4F, "Ottoman Siyaq Numbers"},
+	{0x1EE00, 0x1EEFF, "Arabic Mathematical Alphabetic Symbols"},
+	{0x1F000, 0x1F02F, "Mahjong Tiles"},
+	{0x1F030, 0x1F09F, "Domino Tiles"},
+	{0x1F0A0, 0x1F0FF, "Playing Cards"},
+	{0x1F100, 0x1F1FF, "Enclosed Alphanumeric Supplement"},
+	{0x1F200, 0x1F2FF, "Enclosed Ideographic Supplement"},
+	{0x1F300, 0x1F5FF, "Miscellaneous Symbols and Pictographs"},
+	{0x1F600, 0x1F64F, "Emoticons"},
+	{0x1F650, 0x1F67F, "Ornamental Dingbats"},
+	{0x1F680, 0x1F6FF, "Transport and Map Symbols"},
+	{0x1F700, 0x1F77F, "Alchemical Symbols"},
+	{0x1F780, 0x1F7FF, "Geometric Shapes Extended"},
+	{0x1F800, 0x1F8FF, "Supplemental Arrows-C"},
+	{0x1F900, 0x1F9FF, "Supplemental Symbols and Pictographs"},
+	{0x1FA00, 0x1FA6F, "Chess Symbols"},
+	{0x1FA70, 0x1FAFF, "Symbols and Pictographs Extended-A"},
+	{0x1FB00, 0x1FBFF, "Symbols for Legacy Computing"},
+	{0x20000, 0x2A6DF, "CJK Unified Ideographs Extension B"},
+	{0x2A700, 0x2B73F, "CJK Unified Ideographs Extension C"},
+	{0x2B740, 0x2B81F, "CJK Unified Ideographs Extension D"},
+	{0x2B820, 0x2CEAF, "CJK Unified Ideographs Extension E"},
+	{0x2CEB0, 0x2EBEF, "CJK Unified Ideographs Extension F"},
+	{0x2F800, 0x2FA1F, "CJK Compatibility Ideographs Supplement"},
+	{0x30000, 0x3134F, "CJK Unified Ideographs Extension G"},
+	{0xE0000, 0xE007F, "Tags"},
+	{0xE0100, 0xE01EF, "Variation Selectors Supplement"},
+	{0xF0000, 0xFFFFF, "Supplementary Private Use Area-A"},
+	{0x100000, 0x10FFFF, "Supplementary Private Use Area-B"},
+}
+
+// scripts are the ranges of characters in each script.
+var scripts = []span{
+	{0x0000, 0x0040, "Common"},
+	{0x0041, 0x005A, "Latin"},
+	{0x005B, 0x0060, "Common"},
+	{0x0061, 0x007A, "Latin"},
+	{0x007B, 0x00A9, "Common"},
+	{0x00AA, 0x00AA, "Latin"},
+	{0x00AB, 0x00B9, "Common"},
+	{0x00BA, 0x00BA, "Latin"},
+	{0x00BB, 0x00BF, "Common"},
+	{0x00C0, 0x00D6, "Latin"},
+	{0x00D7, 0x00D7, "Common"},
+	{0x00D8, 0x00F6, "Latin"},
+	{0x00F7, 0x00F7, "Common"},
+	{0x00F8, 0x02B8, "Latin"},
+	{0x02B9, 0x02DF, "Common"},
+	{0x02E0, 0x02E4, "Latin"},
+	{0x02E5, 0x02E9, "Common"},
+	{0x02EA, 0x02EB, "Bopomofo"},
+	{0x02EC, 0x02FF, "Common"},
+	{0x0300, 0x036F, "Inherited"},
+	{0x0370, 0x0373, "Greek"},
+	{0x0374, 0x0374, "Common"},
+	{0x0375, 0x0377, "Greek"},
+	{0x037A, 0x037D, "Greek"},
+	{0x037E, 0x037E, "Common"},
+	{0x037F, 0x037F, "Greek"},
+	{0x0384, 0x0384, "Greek"},
+	{0x0385, 0x0385, "Common"},
+	{0x0386, 0x0386, "Greek"},
+	{0x0387, 0x0387, "Common"},
+	{0x0388, 0x038A, "Greek"},
+	{0x038C, 0x038C, "Greek"},
+	{0x038E, 0x03A1, "Greek"},
+	{0x03A3, 0x03E1, "Greek"},
+	{0x03E2, 0x03EF, "Coptic"},
+	{0x03F0, 0x03FF, "Greek"},
+	{0x0400, 0x0484, "Cyrillic"},
+	{0x0485, 0x0486, "Inherited"},
+	{0x0487, 0x052F, "Cyrillic"},
+	{0x0531, 0x0556, "Armenian"},
+	{0x0559, 0x058A, "Armenian"},
+	{0x058D, 0x058F, "Armenian"},
+	{0x0591, 0x05C7, "Hebrew"},
+	{0x05D0, 0x05EA, "Hebrew"},
+	{0x05EF, 0x05F4, "Hebrew"},
+	{0x0600, 0x0604, "Arabic"},
+	{0x0605, 0x0605, "Common"},
+	{0x0606, 0x060B, "Arabic"},
+	{0x060C, 0x060C, "Common"},
+	{0x060D, 0x061A, "Arabic"},
+	{0x061B, 0x061B, "Common"},
+	{0x061C, 0x061E, "Arabic"},
+	{0x061F, 0x061F, "Common"},
+	{0x0620, 0x063F, "Arabic"},
+	{0x0640, 0x0640, "Common"},
+	{0x0641, 0x064A, "Arabic"},
+	{0x064B, 0x0655, "Inherited"},
+	{0x0656, 0x066F, "Arabic"},
+	{0x0670, 0x0670, "Inherited"},
+	{0x0671, 0x06DC, "Arabic"},
+	{0x06DD, 0x06DD, "Common"},
+	{0x06DE, 0x06FF, "Arabic"},
+	{0x0700, 0x070D, "Syriac"},
+	{0x070F, 0x074A, "Syriac"},
+	{0x074D, 0x074F, "Syriac"},
+	{0x0750, 0x077F, "Arabic"},
+	{0x0780, 0x07B1, "Thaana"},
+	{0x07C0, 0x07FA, "Nko"},
+	{0x07FD, 0x07FF, "Nko"},
+	{0x0800, 0x082D, "Samaritan"},
+	{0x0830, 0x083E, "Samaritan"},
+	{0x0840, 0x085B, "Mandaic"},
+	{0x085E, 0x085E, "Mandaic"},
+	{0x0860, 0x086A, "Syriac"},
+	{0x0870, 0x088E, "Arabic"},
+	{0x0890, 0x0891, "Arabic"},
+	{0x0898, 0x08E1, "Arabic"},
+	{0x08E2, 0x08E2, "Common"},
+	{0x08E3, 0x08FF, "Arabic"},
+	{0x0900, 0x0950, "Devanagari"},
+	{0x0951, 0x0954, "Inherited"},
+	{0x0955, 0x0963, "Devanagari"},
+	{0x0964, 0x0965, "Common"},
+	{0x0966, 0x097F, "Devanagari"},
+	{0x0980, 0x0983, "Bengali"},
+	{0x0985, 0x098C, "Bengali"},
+	{0x098F, 0x0990, "Bengali"},
+	{0x0993, 0x09A8, "Bengali"},
+	{0x09AA, 0x09B0, "Bengali"},
+	{0x09B2, 0x09B2, "Bengali"},
+	{0x09B6, 0x09B9, "Bengali"},
+	{0x09BC, 0x09C4, "Bengali"},
+	{0x09C7, 0x09C8, "Bengali"},
+	{0x09CB, 0x09CE, "Bengali"},
+	{0x09D7, 0x09D7, "Bengali"},
+	{0x09DC, 0x09DD, "Bengali"},
+	{0x09DF, 0x09E3, "Bengali"},
+	{0x09E6, 0x09FE, "Bengali"},
+	{0x0A01, 0x0A03, "Gurmukhi"},
+	{0x0A05, 0x0A0A, "Gurmukhi"},
+	{0x0A0F, 0x0A10, "Gurmukhi"},
+	{0x0A13, 0x0A28, "Gurmukhi"},
+	{0x0A2A, 0x0A30, "Gurmukhi"},
+	{0x0A32, 0x0A33, "Gurmukhi"},
+	{0x0A35, 0x0A36, "Gurmukhi"},
+	{0x0A38, 0x0A39, "Gurmukhi"},
+	{0x0A3C, 0x0A3C, "Gurmukhi"},
+	{0x0A3E, 0x0A42, "Gurmukhi"},
+	{0x0A47, 0x0A48, "Gurmukhi"},
+	{0x0A4B, 0x0A4D, "Gurmukhi"},
+	{0x0A51, 0x0A51, "Gurmukhi"},
+	{0x0A59, 0x0A5C, "Gurmukhi"},
+	{0x0A5E, 0x0A5E, "Gurmukhi"},
+	{0x0A66, 0x0A76, "Gurmukhi"},
+	{0x0A81, 0x0A83, "Gujarati"},
+	{0x0A85, 0x0A8D, "Gujarati"},
+	{0x0A8F, 0x0A91, "Gujarati"},
+	{0x0A93, 0x0AA8, "Gujarati"},
+	{0x0AAA, 0x0AB0, "Gujarati"},
+	{0x0AB2, 0x0AB3, "Gujarati"},
+	{0x0AB5, 0x0AB9, "Gujarati"},
+	{0x0ABC, 0x0AC5, "Gujarati"},
+	{0x0AC7, 0x0AC9, "Gujarati"},
+	{0x0ACB, 0x0ACD, "Gujarati"},
+	{0x0AD0, 0x0AD0, "Gujarati"},
+	{0x0AE0, 0x0AE3, "Gujarati"},
+	{0x0AE6, 0x0AF1, "Gujarati"},
+	{0x0AF9, 0x0AFF, "Gujarati"},
+	{0x0B01, 0x0B03, "Oriya"},
+	{0x0B05, 0x0B0C, "Oriya"},
+	{0x0B0F, 0x0B10, "Oriya"},
+	{0x0B13, 0x0B28, "Oriya"},
+	{0x0B2A, 0x0B30, "Oriya"},
+	{0x0B32, 0x0B33, "Oriya"},
+	{0x0B35, 0x0B39, "Oriya"},
+	{0x0B3C, 0x0B44, "Oriya"},
+	{0x0B47, 0x0B48, "Oriya"},
+	{0x0B4B, 0x0B4D, "Oriya"},
+	{0x0B55, 0x0B57, "Oriya"},
+	{0x0B5C, 0x0B5D, "Oriya"},
+	{0x0B5F, 0x0B63, "Oriya"},
+	{0x0B66, 0x0B77, "Oriya"},
+	{0x0B82, 0x0B83, "Tamil"},
+	{0x0B85, 0x0B8A, "Tamil"},
+	{0x0B8E, 0x0B90, "Tamil"},
+	{0x0B92, 0x0B95, "Tamil"},
+	{0x0B99, 0x0B9A, "Tamil"},
+	{0x0B9C, 0x0B9C, "Tamil"},
+	{0x0B9E, 0x0B9F, "Tamil"},
+	{0x0BA3, 0x0BA4, "Tamil"},
+	{0x0BA8, 0x0BAA, "Tamil"},
+	{0x0BAE, 0x0BB9, "Tamil"},
+	{0x0BBE, 0x0BC2, "Tamil"},
+	{0x0BC6, 0x0BC8, "Tamil"},
+	{0x0BCA, 0x0BCD, "Tamil"},
+	{0x0BD0, 0x0BD0, "Tamil"},
+	{0x0BD7, 0x0BD7, "Tamil"},
+	{0x0BE6, 0x0BFA, "Tamil"},
+	{0x0C00, 0x0C0C, "Telugu"},
+	{0x0C0E, 0x0C10, "Telugu"},
+	{0x0C12, 0x0C28, "Telugu"},
+	{0x0C2A, 0x0C39, "Telugu"},
+	{0x0C3C, 0x0C44, "Telugu"},
+	{0x0C46, 0x0C48, "Telugu"},
+	{0x0C4A, 0x0C4D, "Telugu"},
+	{0x0C55, 0x0C56, "Telugu"},
+	{0x0C58, 0x0C5A, "Telugu"},
+	{0x0C5D, 0x0C5D, "Telugu"},
+	{0x0C60, 0x0C63, "Telugu"},
+	{0x0C66, 0x0C6F, "Telugu"},
+	{0x0C77, 0x0C7F, "Telugu"},
+	{0x0C80, 0x0C8C, "Kannada"},
+	{0x0C8E, 0x0C90, "Kannada"},
+	{0x0C92, 0x0CA8, "Kannada"},
+	{0x0CAA, 0x0CB3, "Kannada"},
+	{0x0CB5, 0x0CB9, "Kannada"},
+	{0x0CBC, 0x0CC4, "Kannada"},
+	{0x0CC6, 0x0CC8, "Kannada"},
+	{0x0CCA, 0x0CCD, "Kannada"},
+	{0x0CD5, 0x0CD6, "Kannada"},
+	{0x0CDD, 0x0CDE, "Kannada"},
+	{0x0CE0, 0x0CE3, "Kannada"},
+	{0x0CE6, 0x0CEF, "Kannada"},
+	{0x0CF1, 0x0CF2, "Kannada"},
+	{0x0D00, 0x0D0C, "Malayalam"},
+	{0x0D0E, 0x0D10, "Malayalam"},
+	{0x0D12, 0x0D44, "Malayalam"},
+	{0x0D46, 0x0D48, "Malayalam"},
+	{0x0D4A, 0x0D4F, "Malayalam"},
+	{0x0D54, 0x0D63, "Malayalam"},
+	{0x0D66, 0x0D7F, "Malayalam"},
+	{0x0D81, 0x0D83, "Sinhala"},
+	{0x0D85, 0x0D96, "Sinhala"},
+	{0x0D9A, 0x0DB1, "Sinhala"},
+	{0x0DB3, 0x0DBB, "Sinhala"},
+	{0x0DBD, 0x0DBD, "Sinhala"},
+	{0x0DC0, 0x0DC6, "Sinhala"},
+	{0x0DCA, 0x0DCA, "Sinhala"},
+	{0x0DCF, 0x0DD4, "Sinhala"},
+	{0x0DD6, 0x0DD6, "Sinhala"},
+	{0x0DD8, 0x0DDF, "Sinhala"},
+	{0x0DE6, 0x0DEF, "Sinhala"},
+	{0x0DF2, 0x0DF4, "Sinhala"},
+	{0x0E01, 0x0E3A, "Thai"},
+	{0x0E3F, 0x0E3F, "Common"},
+	{0x0E40, 0x0E5B, "Thai"},
+	{0x0E81, 0x0E82, "Lao"},
+	{0x0E84, 0x0E84, "Lao"},
+	{0x0E86, 0x0E8A, "Lao"},
+	{0x0E8C, 0x0EA3, "Lao"},
+	{0x0EA5, 0x0EA5, "Lao"},
+	{0x0EA7, 0x0EBD, "Lao"},
+	{0x0EC0, 0x0EC4, "Lao"},
+	{0x0EC6, 0x0EC6, "Lao"},
+	{0x0EC8, 0x0ECD, "Lao"},
+	{0x0ED0, 0x0ED9, "Lao"},
+	{0x0EDC, 0x0EDF, "Lao"},
+	{0x0F00, 0x0F47, "Tibetan"},
+	{0x0F49, 0x0F6C, "Tibetan"},
+	{0x0F71, 0x0F97, "Tibetan"},
+	{0x0F99, 0x0FBC, "Tibetan"},
+	{0x0FBE, 0x0FCC, "Tibetan"},
+	{0x0FCE, 0x0FD4, "Tibetan"},
+	{0x0FD5, 0x0FD8, "Common"},
+	{0x0FD9, 0x0FDA, "Tibetan"},
+	{0x1000, 0x109F, "Myanmar"},
+	{0x10A0, 0x10C5, "Georgian"},
+	{0x10C7, 0x10C7, "Georgian"},
+	{0x10CD, 0x10CD, "Georgian"},
+	{0x10D0, 0x10FA, "Georgian"},
+	{0x10FB, 0x10FB, "Common"},
+	{0x10FC, 0x10FF, "Georgian"},
+	{0x1100, 0x11FF, "Hangul"},
+	{0x1200, 0x1248, "Ethiopic"},
+	{0x124A, 0x124D, "Ethiopic"},
+	{0x1250, 0x1256, "Ethiopic"},
+	{0x1258, 0x1258, "Ethiopic"},
+	{0x125A, 0x125D, "Ethiopic"},
+	{0x1260, 0x1288, "Ethiopic"},
+	{0x128A, 0x128D, "Ethiopic"},
+	{0x1290, 0x12B0, "Ethiopic"},
+	{0x12B2, 0x12B5, "Ethiopic"},
+	{0x12B8, 0x12BE, "Ethiopic"},
+	{0x12C0, 0x12C0, "Ethiopic"},
+	{0x12C2, 0x12C5, "Ethiopic"},
+	{0x12C8, 0x12D6, "Ethiopic"},
+	{0x12D8, 0x1310, "Ethiopic"},
+	{0x1312, 0x1315, "Ethiopic"},
+	{0x1318, 0x135A, "Ethiopic"},
+	{0x135D, 0x137C, "Ethiopic"},
+	{0x1380, 0x1399, "Ethiopic"},
+	{0x13A0, 0x13F5, "Cherokee"},
+	{0x13F8, 0x13FD, "Cherokee"},
+	{0x1400, 0x167F, "Canadian_Aboriginal"},
+	{0x1680, 0x169C, "Ogham"},
+	{0x16A0, 0x16EA, "Runic"},
+	{0x16EB, 0x16ED, "Common"},
+	{0x16EE, 0x16F8, "Runic"},
+	{0x1700, 0x1715, "Tagalog"},
+	{0x171F, 0x171F, "Tagalog"},
+	{0x1720, 0x1734, "Hanunoo"},
+	{0x1735, 0x1736, "Common"},
+	{0x1740, 0x1753, "Buhid"},
+	{0x1760, 0x176C, "Tagbanwa"},
+	{0x176E, 0x1770, "Tagbanwa"},
+	{0x1772, 0x1773, "Tagbanwa"},
+	{0x1780, 0x17DD, "Khmer"},
+	{0x17E0, 0x17E9, "Khmer"},
+	{0x17F0, 0x17F9, "Khmer"},
+	{0x1800, 0x1801, "Mongolian"},
+	{0x1802, 0x1803, "Common"},
+	{0x1804, 0x1804, "Mongolian"},
+	{0x1805, 0x1805, "Common"},
+	{0x1806, 0x1819, "Mongolian"},
+	{0x1820, 0x1878, "Mongolian"},
+	{0x1880, 0x18AA, "Mongolian"},
+	{0x18B0, 0x18F5, "Canadian_Aboriginal"},
+	{0x1900, 0x191E, "Limbu"},
+	{0x1920, 0x192B, "Limbu"},
+	{0x1930, 0x193B, "Limbu"},
+	{0x1940, 0x1940, "Limbu"},
+	{0x1944, 0x194F, "Limbu"},
+	{0x1950, 0x196D, "Tai_Le"},
+	{0x1970, 0x1974, "Tai_Le"},
+	{0x1980, 0x19AB, "New_Tai_Lue"},
+	{0x19B0, 0x19C9, "New_Tai_Lue"},
+	{0x19D0, 0x19DA, "New_Tai_Lue"},
+	{0x19DE, 0x19DF, "New_Tai_Lue"},
+	{0x19E0, 0x19FF, "Khmer"},
+	{0x1A00, 0x1A1B, "Buginese"},
+	{0x1A1E, 0x1A1F, "Buginese"},
+	{0x1A20, 0x1A5E, "Tai_Tham"},
+	{0x1A60, 0x1A7C, "Tai_Tham"},
+	{0x1A7F, 0x1A89, "Tai_Tham"},
+	{0x1A90, 0x1A99, "Tai_Tham"},
+	{0x1AA0, 0x1AAD, "Tai_Tham"},
+	{0x1AB0, 0x1ACE, "Inherited"},
+	{0x1B00, 0x1B4C, "Balinese"},
+	{0x1B50, 0x1B7E, "Balinese"},
+	{0x1B80, 0x1BBF, "Sundanese"},
+	{0x1BC0, 0x1BF3, "Batak"},
+	{0x1BFC, 0x1BFF, "Batak"},
+	{0x1C00, 0x1C37, "Lepcha"},
+	{0x1C3B, 0x1C49, "Lepcha"},
+	{0x1C4D, 0x1C4F, "Lepcha"},
+	{0x1C50, 0x1C7F, "Ol_Chiki"},
+	{0x1C80, 0x1C88, "Cyrillic"},
+	{0x1C90, 0x1CBA, "Georgian"},
+	{0x1CBD, 0x1CBF, "Georgian"},
+	{0x1CC0, 0x1CC7, "Sundanese"},
+	{0x1CD0, 0x1CD2, "Inherited"},
+	{0x1CD3, 0x1CD3, "Common"},
+	{0x1CD4, 0x1CE0, "Inherited"},
+	{0x1CE1, 0x1CE1, "Common"},
+	{0x1CE2, 0x1CE8, "Inherited"},
+	{0x1CE9, 0x1CEC, "Common"},
+	{0x1CED, 0x1CED, "Inherited"},
+	{0x1CEE, 0x1CF3, "Common"},
+	{0x1CF4, 0x1CF4, "Inherited"},
+	{0x1CF5, 0x1CF7, "Common"},
+	{0x1CF8, 0x1CF9, "Inherited"},
+	{0x1CFA, 0x1CFA, "Common"},
+	{0x1D00, 0x1D25, "Latin"},
+	{0x1D26, 0x1D2A, "Greek"},
+	{0x1D2B, 0x1D2B, "Cyrillic"},
+	{0x1D2C, 0x1D5C, "Latin"},
+	{0x1D5D, 0x1D61, "Greek"},
+	{0x1D62, 0x1D65, "Latin"},
+	{0x1D66, 0x1D6A, "Greek"},
+	{0x1D6B, 0x1D77, "Latin"},
+	{0x1D78, 0x1D78, "Cyrillic"},
+	{0x1D79, 0x1DBE, "Latin"},
+	{0x1DBF, 0x1DBF, "Greek"},
+	{0x1DC0, 0x1DFF, "Inherited"},
+	{0x1E00, 0x1EFF, "Latin"},
+	{0x1F00, 0x1F15, "Greek"},
+	{0x1F18, 0x1F1D, "Greek"},
+	{0x1F20, 0x1F45, "Greek"},
+	{0x1F48, 0x1F4D, "Greek"},
+	{0x1F50, 0x1F57, "Greek"},
+	{0x1F59, 0x1F59, "Greek"},
+	{0x1F5B, 0x1F5B, "Greek"},
+	{0x1F5D, 0x1F5D, "Greek"},
+	{0x1F5F, 0x1F7D, "Greek"},
+	{0x1F80, 0x1FB4, "Greek"},
+	{0x1FB6, 0x1FC4, "Greek"},
+	{0x1FC6, 0x1FD3, "Greek"},
+	{0x1FD6, 0x1FDB, "Greek"},
+	{0x1FDD, 0x1FEF, "Greek"},
+	{0x1FF2, 0x1FF4, "Greek"},
+	{0x1FF6, 0x1FFE, "Greek"},
+	{0x2000, 0x200B, "Common"},
+	{0x200C, 0x200D, "Inherited"},
+	{0x200E, 0x2064, "Common"},
+	{0x2066, 0x2070, "Common"},
+	{0x2071, 0x2071, "Latin"},
+	{0x2074, 0x207E, "Common"},
+	{0x207F, 0x207F, "Latin"},
+	{0x2080, 0x208E, "Common"},
+	{0x2090, 0x209C, "Latin"},
+	{0x20A0, 0x20C0, "Common"},
+	{0x20D0, 0x20F0, "Inherited"},
+	{0x2100, 0x2125, "Common"},
+	{0x2126, 0x2126, "Greek"},
+	{0x2127, 0x2129, "Common"},
+	{0x212A, 0x212B, "Latin"},
+	{0x212C, 0x2131, "Common"},
+	{0x2132, 0x2132, "Latin"},
+	{0x2133, 0x214D, "Common"},
+	{0x214E, 0x214E, "Latin"},
+	{0x214F, 0x215F, "Common"},
+	{0x2160, 0x2188, "Latin"},
+	{0x2189, 0x218B, "Common"},
+	{0x2190, 0x2426, "Common"},
+	{0x2440, 0x244A, "Common"},
+	{0x2460, 0x27FF, "Common"},
+	{0x2800, 0x28FF, "Braille"},
+	{0x2900, 0x2B73, "Common"},
+	{0x2B76, 0x2B95, "Common"},
+	{0x2B97, 0x2BFF, "Common"},
+	{0x2C00, 0x2C5F, "Glagolitic"},
+	{0x2C60, 0x2C7F, "Latin"},
+	{0x2C80, 0x2CF3, "Coptic"},
+	{0x2CF9, 0x2CFF, "Coptic"},
+	{0x2D00, 0x2D25, "Georgian"},
+	{0x2D27, 0x2D27, "Georgian"},
+	{0x2D2D, 0x2D2D, "Georgian"},
+	{0x2D30, 0x2D67, "Tifinagh"},
+	{0x2D6F, 0x2D70, "Tifinagh"},
+	{0x2D7F, 0x2D7F, "Tifinagh"},
+	{0x2D80, 0x2D96, "Ethiopic"},
+	{0x2DA0, 0x2DA6, "Ethiopic"},
+	{0x2DA8, 0x2DAE, "Ethiopic"},
+	{0x2DB0, 0x2DB6, "Ethiopic"},
+	{0x2DB8, 0x2DBE, "Ethiopic"},
+	{0x2DC0, 0x2DC6, "Ethiopic"},
+	{0x2DC8, 0x2DCE, "Ethiopic"},
+	{0x2DD0, 0x2DD6, "Ethiopic"},
+	{0x2DD8, 0x2DDE, "Ethiopic"},
+	{0x2DE0, 0x2DFF, "Cyrillic"},
+	{0x2E00, 0x2E5D, "Common"},
+	{0x2E80, 0x2E99, "Han"},
+	{0x2E9B, 0x2EF3, "Han"},
+	{0x2F00, 0x2FD5, "Han"},
+	{0x2FF0, 0x2FFB, "Common"},
+	{0x3000, 0x3004, "Common"},
+	{0x3005, 0x3005, "Han"},
+	{0x3006, 0x3006, "Common"},
+	{0x3007, 0x3007, "Han"},
+	{0x3008, 0x3020, "Common"},
+	{0x3021, 0x3029, "Han"},
+	{0x302A, 0x302D, "Inherited"},
+	{0x302E, 0x302F, "Hangul"},
+	{0x3030, 0x3037, "Common"},
+	{0x3038, 0x303B, "Han"},
+	{0x303C, 0x303F, "Common"},
+	{0x3041, 0x3096, "Hiragana"},
+	{0x3099, 0x309A, "Inherited"},
+	{0x309B, 0x309C, "Common"},
+	{0x309D, 0x309F, "Hiragana"},
+	{0x30A0, 0x30A0, "Common"},
+	{0x30A1, 0x30FA, "Katakana"},
+	{0x30FB, 0x30FC, "Common"},
+	{0x30FD, 0x30FF, "Katakana"},
+	{0x3105, 0x312F, "Bopomofo"},
+	{0x3131, 0x318E, "Hangul"},
+	{0x3190, 0x319F, "Common"},
+	{0x31A0, 0x31BF, "Bopomofo"},
+	{0x31C0, 0x31E3, "Common"},
+	{0x31F0, 0x31FF, "Katakana"},
+	{0x3200, 0x321E, "Hangul"},
+	{0x3220, 0x325F, "Common"},
+	{0x3260, 0x327E, "Hangul"},
+	{0x327F, 0x32CF, "Common"},
+	{0x32D0, 0x32FE, "Katakana"},
+	{0x32FF, 0x32FF, "Common"},
+	{0x3300, 0x3357, "Katakana"},
+	{0x3358, 0x33FF, "Common"},
+	{0x3400, 0x4DBF, "Han"},
+	{0x4DC0, 0x4DFF, "Common"},
+	{0x4E00, 0x9FFF, "Han"},
+	{0xA000, 0xA48C, "Yi"},
+	{0xA490, 0xA4C6, "Yi"},
+	{0xA4D0, 0xA4FF, "Lisu"},
+	{0xA500, 0xA62B, "Vai"},
+	{0xA640, 0xA69F, "Cyrillic"},
+	{0xA6A0, 0xA6F7, "Bamum"},
+	{0xA700, 0xA721, "Common"},
+	{0xA722, 0xA787, "Latin"},
+	{0xA788, 0xA78A, "Common"},
+	{0xA78B, 0xA7CA, "Latin"},
+	{0xA7D0, 0xA7D1, "Latin"},
+	{0xA7D3, 0xA7D3, "Latin"},
+	{0xA7D5, 0xA7D9, "Latin"},
+	{0xA7F2, 0xA7FF, "Latin"},
+	{0xA800, 0xA82C, "Syloti_Nagri"},
+	{0xA830, 0xA839, "Common"},
+	{0xA840, 0xA877, "Phags_Pa"},
+	{0xA880, 0xA8C5, "Saurashtra"},
+	{0xA8CE, 0xA8D9, "Saurashtra"},
+	{0xA8E0, 0xA8FF, "Devanagari"},
+	{0xA900, 0xA92D, "Kayah_Li"},
+	{0xA92E, 0xA92E, "Common"},
+	{0xA92F, 0xA92F, "Kayah_Li"},
+	{0xA930, 0xA953, "Rejang"},
+	{0xA95F, 0xA95F, "Rejang"},
+	{0xA960, 0xA97C, "Hangul"},
+	{0xA980, 0xA9CD, "Javanese"},
+	{0xA9CF, 0xA9CF, "Common"},
+	{0xA9D0, 0xA9D9, "Javanese"},
+	{0xA9DE, 0xA9DF, "Javanese"},
+	{0xA9E0, 0xA9FE, "Myanmar"},
+	{0xAA00, 0xAA36, "Cham"},
+	{0xAA40, 0xAA4D, "Cham"},
+	{0xAA50, 0xAA59, "Cham"},
+	{0xAA5C, 0xAA5F, "Cham"},
+	{0xAA60, 0xAA7F, "Myanmar"},
+	{0xAA80, 0xAAC2, "Tai_Viet"},
+	{0xAADB, 0xAADF, "Tai_Viet"},
+	{0xAAE0, 0xAAF6, "Meetei_Mayek"},
+	{0xAB01, 0xAB06, "Ethiopic"},
+	{0xAB09, 0xAB0E, "Ethiopic"},
+	{0xAB11, 0xAB16, "Ethiopic"},
+	{0xAB20, 0xAB26, "Ethiopic"},
+	{0xAB28, 0xAB2E, "Ethiopic"},
+	{0xAB30, 0xAB5A, "Latin"},
+	{0xAB5B, 0xAB5B, "Common"},
+	{0xAB5C, 0xAB64, "Latin"},
+	{0xAB65, 0xAB65, "Greek"},
+	{0xAB66, 0xAB69, "Latin"},
+	{0xAB6A, 0xAB6B, "Common"},
+	{0xAB70, 0xABBF, "Cherokee"},
+	{0xABC0, 0xABED, "Meetei_Mayek"},
+	{0xABF0, 0xABF9, "Meetei_Mayek"},
+	{0xAC00, 0xD7A3, "Hangul"},
+	{0xD7B0, 0xD7C6, "Hangul"},
+	{0xD7CB, 0xD7FB, "Hangul"},
+	{0xF900, 0xFA6D, "Han"},
+	{0xFA70, 0xFAD9, "Han"},
+	{0xFB00, 0xFB06, "Latin"},
+	{0xFB13, 0xFB17, "Armenian"},
+	{0xFB1D, 0xFB36, "Hebrew"},
+	{0xFB38, 0xFB3C, "Hebrew"},
+	{0xFB3E, 0xFB3E, "Hebrew"},
+	{0xFB40, 0xFB41, "Hebrew"},
+	{0xFB43, 0xFB44, "Hebrew"},
+	{0xFB46, 0xFB4F, "Hebrew"},
+	{0xFB50, 0xFBC2, "Arabic"},
+	{0xFBD3, 0xFD3D, "Arabic"},
+	{0xFD3E, 0xFD3F, "Common"},
+	{0xFD40, 0xFD8F, "Arabic"},
+	{0xFD92, 0xFDC7, "Arabic"},
+	{0xFDCF, 0xFDCF, "Arabic"},
+	{0xFDF0, 0xFDFF, "Arabic"},
+	{0xFE00, 0xFE0F, "Inherited"},
+	{0xFE10, 0xFE19, "Common"},
+	{0xFE20, 0xFE2D, "Inherited"},
+	{0xFE2E, 0xFE2F, "Cyrillic"},
+	{0xFE30, 0xFE52, "Common"},
+	{0xFE54, 0xFE66, "Common"},
+	{0xFE68, 0xFE6B, "Common"},
+	{0xFE70, 0xFE74, "Arabic"},
+	{0xFE76, 0xFEFC, "Arabic"},
+	{0xFEFF, 0xFEFF, "Common"},
+	{0xFF01, 0xFF20, "Common"},
+	{0xFF21, 0xFF3A, "Latin"},
+	{0xFF3B, 0xFF40, "Common"},
+	{0xFF41, 0xFF5A, "Latin"},
+	{0xFF5B, 0xFF65, "Common"},
+	{0xFF66, 0xFF6F, "Katakana"},
+	{0xFF70, 0xFF70, "Common"},
+	{0xFF71, 0xFF9D, "Katakana"},
+	{0xFF9E, 0xFF9F, "Common"},
+	{0xFFA0, 0xFFBE, "Hangul"},
+	{0xFFC2, 0xFFC7, "Hangul"},
+	{0xFFCA, 0xFFCF, "Hangul"},
+	{0xFFD2, 0xFFD7, "Hangul"},
+	{0xFFDA, 0xFFDC, "Hangul"},
+	{0xFFE0, 0xFFE6, "Common"},
+	{0xFFE8, 0xFFEE, "Common"},
+	{0xFFF9, 0xFFFD, "Common"},
+	{0x10000, 0x1000B, "Linear_B"},
+	{0x1000D, 0x10026, "Linear_B"},
+	{0x10028, 0x1003A, "Linear_B"},
+	{0x1003C, 0x1003D, "Linear_B"},
+	{0x1003F, 0x1004D, "Linear_B"},
+	{0x10050, 0x1005D, "Linear_B"},
+	{0x10080, 0x100FA, "Linear_B"},
+	{0x10100, 0x10102, "Common"},
+	{0x10107, 0x10133, "Common"},
+	{0x10137, 0x1013F, "Common"},
+	{0x10140, 0x1018E, "Greek"},
+	{0x10190, 0x1019C, "Common"},
+	{0x101A0, 0x101A0, "Greek"},
+	{0x101D0, 0x101FC, "Common"},
+	{0x101FD, 0x101FD, "Inherited"},
+	{0x10280, 0x1029C, "Lycian"},
+	{0x102A0, 0x102D0, "Carian"},
+	{0x102E0, 0x102E0, "Inherited"},
+	{0x102E1, 0x102FB, "Common"},
+	{0x10300, 0x10323, "Old_Italic"},
+	{0x1032D, 0x1032F, "Old_Italic"},
+	{0x10330, 0x1034A, "Gothic"},
+	{0x10350, 0x1037A, "Old_Permic"},
+	{0x10380, 0x1039D, "Ugaritic"},
+	{0x1039F, 0x1039F, "Ugaritic"},
+	{0x103A0, 0x103C3, "Old_Persian"},
+	{0x103C8, 0x103D5, "Old_Persian"},
+	{0x10400, 0x1044F, "Deseret"},
+	{0x10450, 0x1047F, "Shavian"},
+	{0x10480, 0x1049D, "Osmanya"},
+	{0x104A0, 0x104A9, "Osmanya"},
+	{0x104B0, 0x104D3, "Osage"},
+	{0x104D8, 0x104FB, "Osage"},
+	{0x10500, 0x10527, "Elbasan"},
+	{0x10530, 0x10563, "Caucasian_Albanian"},
+	{0x1056F, 0x1056F, "Caucasian_Albanian"},
+	{0x10570, 0x1057A, "Vithkuqi"},
+	{0x1057C, 0x1058A, "Vithkuqi"},
+	{0x1058C, 0x10592, "Vithkuqi"},
+	{0x10594, 0x10595, "Vithkuqi"},
+	{0x10597, 0x105A1, "Vithkuqi"},
+	{0x105A3, 0x105B1, "Vithkuqi"},
+	{0x105B3, 0x105B9, "Vithkuqi"},
+	{0x105BB, 0x105BC, "Vithkuqi"},
+	{0x10600, 0x10736, "Linear_A"},
+	{0x10740, 0x10755, "Linear_A"},
+	{0x10760, 0x10767, "Linear_A"},
+	{0x10780, 0x10785, "Latin"},
+	{0x10787, 0x107B0, "Latin"},
+	{0x107B2, 0x107BA, "Latin"},
+	{0x10800, 0x10805, "Cypriot"},
+	{0x10808, 0x10808, "Cypriot"},
+	{0x1080A, 0x10835, "Cypriot"},
+	{0x10837, 0x10838, "Cypriot"},
+	{0x1083C, 0x1083C, "Cypriot"},
+	{0x1083F, 0x1083F, "Cypriot"},
+	{0x10840, 0x10855, "Imperial_Aramaic"},
+	{0x10857, 0x1085F, "Imperial_Aramaic"},
+	{0x10860, 0x1087F, "Palmyrene"},
+	{0x10880, 0x1089E, "Nabataean"},
+	{0x108A7, 0x108AF, "Nabataean"},
+	{0x108E0, 0x108F2, "Hatran"},
+	{0x108F4, 0x108F5, "Hatran"},
+	{0x108FB, 0x108FF, "Hatran"},
+	{0x10900, 0x1091B, "Phoenician"},
+	{0x1091F, 0x1091F, "Phoenician"},
+	{0x10920, 0x10939, "Lydian"},
+	{0x1093F, 0x1093F, "Lydian"},
+	{0x10980, 0x1099F, "Meroitic_Hieroglyphs"},
+	{0x109A0, 0x109B7, "Meroitic_Cursive"},
+	{0x109BC, 0x109CF, "Meroitic_Cursive"},
+	{0x109D2, 0x109FF, "Meroitic_Cursive"},
+	{0x10A00, 0x10A03, "Kharoshthi"},
+	{0x10A05, 0x10A06, "Kharoshthi"},
+	{0x10A0C, 0x10A13, "Kharoshthi"},
+	{0x10A15, 0x10A17, "Kharoshthi"},
+	{0x10A19, 0x10A35, "Kharoshthi"},
+	{0x10A38, 0x10A3A, "Kharoshthi"},
+	{0x10A3F, 0x10A48, "Kharoshthi"},
+	{0x10A50, 0x10A58, "Kharoshthi"},
+	{0x10A60, 0x10A7F, "Old_South_Arabian"},
+	{0x10A80, 0x10A9F, "Old_North_Arabian"},
+	{0x10AC0, 0x10AE6, "Manichaean"},
+	{0x10AEB, 0x10AF6, "Manichaean"},
+	{0x10B00, 0x10B35, "Avestan"},
+	{0x10B39, 0x10B3F, "Avestan"},
+	{0x10B40, 0x10B55, "Inscriptional_Parthian"},
+	{0x10B58, 0x10B5F, "Inscriptional_Parthian"},
+	{0x10B60, 0x10B72, "Inscriptional_Pahlavi"},
+	{0x10B78, 0x10B7F, "Inscriptional_Pahlavi"},
+	{0x10B80, 0x10B91, "Psalter_Pahlavi"},
+	{0x10B99, 0x10B9C, "Psalter_Pahlavi"},
+	{0x10BA9, 0x10BAF, "Psalter_Pahlavi"},
+	{0x10C00, 0x10C48, "Old_Turkic"},
+	{0x10C80, 0x10CB2, "Old_Hungarian"},
+	{0x10CC0, 0x10CF2, "Old_Hungarian"},
+	{0x10CFA, 0x10CFF, "Old_Hungarian"},
+	{0x10D00, 0x10D27, "Hanifi_Rohingya"},
+	{0x10D30, 0x10D39, "Hanifi_Rohingya"},
+	{0x10E60, 0x10E7E, "Arabic"},
+	{0x10E80, 0x10EA9, "Yezidi"},
+	{0x10EAB, 0x10EAD, "Yezidi"},
+	{0x10EB0, 0x10EB1, "Yezidi"},
+	{0x10F00, 0x10F27, "Old_Sogdian"},
+	{0x10F30, 0x10F59, "Sogdian"},
+	{0x10F70, 0x10F89, "Old_Uyghur"},
+	{0x10FB0, 0x10FCB, "Chorasmian"},
+	{0x10FE0, 0x10FF6, "Elymaic"},
+	{0x11000, 0x1104D, "Brahmi"},
+	{0x11052, 0x11075, "Brahmi"},
+	{0x1107F, 0x1107F, "Brahmi"},
+	{0x11080, 0x110C2, "Kaithi"},
+	{0x110CD, 0x110CD, "Kaithi"},
+	{0x110D0, 0x110E8, "Sora_Sompeng"},
+	{0x110F0, 0x110F9, "Sora_Sompeng"},
+	{0x11100, 0x11134, "Chakma"},
+	{0x11136, 0x11147, "Chakma"},
+	{0x11150, 0x11176, "Mahajani"},
+	{0x11180, 0x111DF, "Sharada"},
+	{0x111E1, 0x111F4, "Sinhala"},
+	{0x11200, 0x11211, "Khojki"},
+	{0x11213, 0x1123E, "Khojki"},
+	{0x11280, 0x11286, "Multani"},
+	{0x11288, 0x11288, "Multani"},
+	{0x1128A, 0x1128D, "Multani"},
+	{0x1128F, 0x1129D, "Multani"},
+	{0x1129F, 0x112A9, "Multani"},
+	{0x112B0, 0x112EA, "Khudawadi"},
+	{0x112F0, 0x112F9, "Khudawadi"},
+	{0x11300, 0x11303, "Grantha"},
+	{0x11305, 0x1130C, "Grantha"},
+	{0x1130F, 0x11310, "Grantha"},
+	{0x11313, 0x11328, "Grantha"},
+	{0x1132A, 0x11330, "Grantha"},
+	{0x11332, 0x11333, "Grantha"},
+	{0x11335, 0x11339, "Grantha"},
+	{0x1133B, 0x1133B, "Inherited"},
+	{0x1133C, 0x11344, "Grantha"},
+	{0x11347, 0x11348, "Grantha"},
+	{0x1134B, 0x1134D, "Grantha"},
+	{0x11350, 0x11350, "Grantha"},
+	{0x11357, 0x11357, "Grantha"},
+	{0x1135D, 0x11363, "Grantha"},
+	{0x11366, 0x1136C, "Grantha"},
+	{0x11370, 0x11374, "Grantha"},
+	{0x11400, 0x1145B, "Newa"},
+	{0x1145D, 0x11461, "Newa"},
+	{0x11480, 0x114C7, "Tirhuta"},
+	{0x114D0, 0x114D9, "Tirhuta"},
+	{0x11580, 0x115B5, "Siddham"},
+	{0x115B8, 0x115DD, "Siddham"},
+	{0x11600, 0x11644, "Modi"},
+	{0x11650, 0x11659, "Modi"},
+	{0x11660, 0x1166C, "Mongolian"},
+	{0x11680, 0x116B9, "Takri"},
+	{0x116C0, 0x116C9, "Takri"},
+	{0x11700, 0x1171A, "Ahom"},
+	{0x1171D, 0x1172B, "Ahom"},
+	{0x11730, 0x11746, "Ahom"},
+	{0x11800, 0x1183B, "Dogra"},
+	{0x118A0, 0x118F2, "Warang_Citi"},
+	{0x118FF, 0x118FF, "Warang_Citi"},
+	{0x11900, 0x11906, "Dives_Akuru"},
+	{0x11909, 0x11909, "Dives_Akuru"},
+	{0x1190C, 0x11913, "Dives_Akuru"},
+	{0x11915, 0x11916, "Dives_Akuru"},
+	{0x11918, 0x11935, "Dives_Akuru"},
+	{0x11937, 0x11938, "Dives_Akuru"},
+	{0x1193B, 0x11946, "Dives_Akuru"},
+	{0x11950, 0x11959, "Dives_Akuru"},
+	{0x119A0, 0x119A7, "Nandinagari"},
+	{0x119AA, 0x119D7, "Nandinagari"},
+	{0x119DA, 0x119E4, "Nandinagari"},
+	{0x11A00, 0x11A47, "Zanabazar_Square"},
+	{0x11A50, 0x11AA2, "Soyombo"},
+	{0x11AB0, 0x11ABF, "Canadian_Aboriginal"},
+	{0x11AC0, 0x11AF8, "Pau_Cin_Hau"},
+	{0x11C00, 0x11C08, "Bhaiksuki"},
+	{0x11C0A, 0x11C36, "Bhaiksuki"},
+	{0x11C38, 0x11C45, "Bhaiksuki"},
+	{0x11C50, 0x11C6C, "Bhaiksuki"},
+	{0x11C70, 0x11C8F, "Marchen"},
+	{0x11C92, 0x11CA7, "Marchen"},
+	{0x11CA9, 0x11CB6, "Marchen"},
+	{0x11D00, 0x11D06, "Masaram_Gondi"},
+	{0x11D08, 0x11D09, "Masaram_Gondi"},
+	{0x11D0B, 0x11D36, "Masaram_Gondi"},
+	{0x11D3A, 0x11D3A, "Masaram_Gondi"},
+	{0x11D3C, 0x11D3D, "Masaram_Gondi"},
+	{0x11D3F, 0x11D47, "Masaram_Gondi"},
+	{0x11D50, 0x11D59, "Masaram_Gondi"},
+	{0x11D60, 0x11D65, "Gunjala_Gondi"},
+	{0x11D67, 0x11D68, "Gunjala_Gondi"},
+	{0x11D6A, 0x11D8E, "Gunjala_Gondi"},
+	{0x11D90, 0x11D91, "Gunjala_Gondi"},
+	{0x11D93, 0x11D98, "Gunjala_Gondi"},
+	{0x11DA0, 0x11DA9, "Gunjala_Gondi"},
+	{0x11EE0, 0x11EF8, "Makasar"},
+	{0x11FB0, 0x11FB0, "Lisu"},
+	{0x11FC0, 0x11FF1, "Tamil"},
+	{0x11FFF, 0x11FFF, "Tamil"},
+	{0x12000, 0x12399, "Cuneiform"},
+	{0x12400, 0x1246E, "Cuneiform"},
+	{0x12470, 0x12474, "Cuneiform"},
+	{0x12480, 0x12543, "Cuneiform"},
+	{0x12F90, 0x12FF2, "Cypro_Minoan"},
+	{0x13000, 0x1342E, "Egyptian_Hieroglyphs"},
+	{0x13430, 0x13438, "Egyptian_Hieroglyphs"},
+	{0x14400, 0x14646, "Anatolian_Hieroglyphs"},
+	{0x16800, 0x16A38, "Bamum"},
+	{0x16A40, 0x16A5E, "Mro"},
+	{0x16A60, 0x16A69, "Mro"},
+	{0x16A6E, 0x16A6F, "Mro"},
+	{0x16A70, 0x16ABE, "Tangsa"},
+	{0x16AC0, 0x16AC9, "Tangsa"},
+	{0x16AD0, 0x16AED, "Bassa_Vah"},
+	{0x16AF0, 0x16AF5, "Bassa_Vah"},
+	{0x16B00, 0x16B45, "Pahawh_Hmong"},
+	{0x16B50, 0x16B59, "Pahawh_Hmong"},
+	{0x16B5B, 0x16B61, "Pahawh_Hmong"},
+	{0x16B63, 0x16B77, "Pahawh_Hmong"},
+	{0x16B7D, 0x16B8F, "Pahawh_Hmong"},
+	{0x16E40, 0x16E9A, "Medefaidrin"},
+	{0x16F00, 0x16F4A, "Miao"},
+	{0x16F4F, 0x16F87, "Miao"},
+	{0x16F8F, 0x16F9F, "Miao"},
+	{0x16FE0, 0x16FE0, "Tangut"},
+	{0x16FE1, 0x16FE1, "Nushu"},
+	{0x16FE2, 0x16FE3, "Han"},
+	{0x16FE4, 0x16FE4, "Khitan_Small_Script"},
+	{0x16FF0, 0x16FF1, "Han"},
+	{0x17000, 0x187F7, "Tangut"},
+	{0x18800, 0x18AFF, "Tangut"},
+	{0x18B00, 0x18CD5, "Khitan_Small_Script"},
+	{0x18D00, 0x18D08, "Tangut"},
+	{0x1AFF0, 0x1AFF3, "Katakana"},
+	{0x1AFF5, 0x1AFFB, "Katakana"},
+	{0x1AFFD, 0x1AFFE, "Katakana"},
+	{0x1B000, 0x1B000, "Katakana"},
+	{0x1B001, 0x1B11F, "Hiragana"},
+	{0x1B120, 0x1B122, "Katakana"},
+	{0x1B150, 0x1B152, "Hiragana"},
+	{0x1B164, 0x1B167, "Katakana"},
+	{0x1B170, 0x1B2FB, "Nushu"},
+	{0x1BC00, 0x1BC6A, "Duployan"},
+	{0x1BC70, 0x1BC7C, "Duployan"},
+	{0x1BC80, 0x1BC88, "Duployan"},
+	{0x1BC90, 0x1BC99, "Duployan"},
+	{0x1BC9C, 0x1BC9F, "Duployan"},
+	{0x1BCA0, 0x1BCA3, "Common"},
+	{0x1CF00, 0x1CF2D, "Inherited"},
+	{0x1CF30, 0x1CF46, "Inherited"},
+	{0x1CF50, 0x1CFC3, "Common"},
+	{0x1D000, 0x1D0F5, "Common"},
+	{0x1D100, 0x1D126, "Common"},
+	{0x1D129, 0x1D166, "Common"},
+	{0x1D167, 0x1D169, "Inherited"},
+	{0x1D16A, 0x1D17A, "Common"},
+	{0x1D17B, 0x1D182, "Inherited"},
+	{0x1D183, 0x1D184, "Common"},
+	{0x1D185, 0x1D18B, "Inherited"},
+	{0x1D18C, 0x1D1A9, "Common"},
+	{0x1D1AA, 0x1D1AD, "Inherited"},
+	{0x1D1AE, 0x1D1EA, "Common"},
+	{0x1D200, 0x1D245, "Greek"},
+	{0x1D2E0, 0x1D2F3, "Common"},
+	{0x1D300, 0x1D356, "Common"},
+	{0x1D360, 0x1D378, "Common"},
+	{0x1D400, 0x1D454, "Common"},
+	{0x1D456, 0x1D49C, "Common"},
+	{0x1D49E, 0x1D49F, "Common"},
+	{0x1D4A2, 0x1D4A2, "Common"},
+	{0x1D4A5, 0x1D4A6, "Common"},
+	{0x1D4A9, 0x1D4AC, "Common"},
+	{0x1D4AE, 0x1D4B9, "Common"},
+	{0x1D4BB, 0x1D4BB, "Common"},
+	{0x1D4BD, 0x1D4C3, "Common"},
+	{0x1D4C5, 0x1D505, "Common"},
+	{0x1D507, 0x1D50A, "Common"},
+	{0x1D50D, 0x1D514, "Common"},
+	{0x1D516, 0x1D51C, "Common"},
+	{0x1D51E, 0x1D539, "Common"},
+	{0x1D53B, 0x1D53E, "Common"},
+	{0x1D540, 0x1D544, "Common"},
+	{0x1D546, 0x1D546, "Common"},
+	{0x1D54A, 0x1D550, "Common"},
+	{0x1D552, 0x1D6A5, "Common"},
+	{0x1D6A8, 0x1D7CB, "Common"},
+	{0x1D7CE, 0x1D7FF, "Common"},
+	{0x1D800, 0x1DA8B, "SignWriting"},
+	{0x1DA9B, 0x1DA9F, "SignWriting"},
+	{0x1DAA1, 0x1DAAF, "SignWriting"},
+	{0x1DF00, 0x1DF1E, "Latin"},
+	{0x1E000, 0x1E006, "Glagolitic"},
+	{0x1E008, 0x1E018, "Glagolitic"},
+	{0x1E01B, 0x1E021, "Glagolitic"},
+	{0x1E023, 0x1E024, "Glagolitic"},
+	{0x1E026, 0x1E02A, "Glagolitic"},
+	{0x1E100, 0x1E12C, "Nyiakeng_Puachue_Hmong"},
+	{0x1E130, 0x1E13D, "Nyiakeng_Puachue_Hmong"},
+	{0x1E140, 0x1E149, "Nyiakeng_Puachue_Hmong"},
+	{0x1E14E, 0x1E14F, "Nyiakeng_Puachue_Hmong"},
+	{0x1E290, 0x1E2AE, "Toto"},
+	{0x1E2C0, 0x1E2F9, "Wancho"},
+	{0x1E2FF, 0x1E2FF, "Wancho"},
+	{0x1E7E0, 0x1E7E6, "Ethiopic"},
+	{0x1E7E8, 0x1E7EB, "Ethiopic"},
+	{0x1E7ED, 0x1E7EE, "Ethiopic"},
+	{0x1E7F0, 0x1E7FE, "Ethiopic"},
+	{0x1E800, 0x1E8C4, "Mende_Kikakui"},
+	{0x1E8C7, 0x1E8D6, "Mende_Kikakui"},
+	{0x1E900, 0x1E94B, "Adlam"},
+	{0x1E950, 0x1E959, "Adlam"},
+	{0x1E95E, 0x1E95F, "Adlam"},
+	{0x1EC71, 0x1ECB4, "Common"},
+	{0x1ED01, 0x1ED3D, "Common"},
+	{0x1EE00, 0x1EE03, "Arabic"},
+	{0x1EE05, 0x1EE1F, "Arabic"},
+	{0x1EE21, 0x1EE22, "Arabic"},
+	{0x1EE24, 0x1EE24, "Arabic"},
+	{0x1EE27, 0x1EE27, "Arabic"},
+	{0x1EE29, 0x1EE32, "Arabic"},
+	{0x1EE34, 0x1EE37, "Arabic"},
+	{0x1EE39, 0x1EE39, "Arabic"},
+	{0x1EE3B, 0x1EE3B, "Arabic"},
+	{0x1EE42, 0x1EE42, "Arabic"},
+	{0x1EE47, 0x1EE47, "Arabic"},
+	{0x1EE49, 0x1EE49, "Arabic"},
+	{0x1EE4B, 0x1EE4B, "Arabic"},
+	{0x1EE4D, 0x1EE4F, "Arabic"},
+	{0x1EE51, 0x1EE52, "Arabic"},
+	{0x1EE54, 0x1EE54, "Arabic"},
+	{0x1EE57, 0x1EE57, "Arabic"},
+	{0x1EE59, 0x1EE59, "Arabic"},
+	{0x1EE5B, 0x1EE5B, "Arabic"},
+	{0x1EE5D, 0x1EE5D, "Arabic"},
+	{0x1EE5F, 0x1EE5F, "Arabic"},
+	{0x1EE61, 0x1EE62, "Arabic"},
+	{0x1EE64, 0x1EE64, "Arabic"},
+	{0x1EE67, 0x1EE6A, "Arabic"},
+	{0x1EE6C, 0x1EE72, "Arabic"},
+	{0x1EE74, 0x1EE77, "Arabic"},
+	{0x1EE79, 0x1EE7C, "Arabic"},
+	{0x1EE7E, 0x1EE7E, "Arabic"},
+	{0x1EE80, 0x1EE89, "Arabic"},
+	{0x1EE8B, 0x1EE9B, "Arabic"},
+	{0x1EEA1, 0x1EEA3, "Arabic"},
+	{0x1EEA5, 0x1EEA9, "Arabic"},
+	{0x1EEAB, 0x1EEBB, "Arabic"},
+	{0x1EEF0, 0x1EEF1, "Arabic"},
+	{0x1F000, 0x1F02B, "Common"},
+	{0x1F030, 0x1F093, "Common"},
+	{0x1F0A0, 0x1F0AE, "Common"},
+	{0x1F0B1, 0x1F0BF, "Common"},
+	{0x1F0C1, 0x1F0CF, "Common"},
+	{0x1F0D1, 0x1F0F5, "Common"},
+	{0x1F100, 0x1F1AD, "Common"},
+	{0x1F1E6, 0x1F1FF, "Common"},
+	{0x1F200, 0x1F200, "Hiragana"},
+	{0x1F201, 0x1F202, "Common"},
+	{0x1F210, 0x1F23B, "Common"},
+	{0x1F240, 0x1F248, "Common"},
+	{0x1F250, 0x1F251, "Common"},
+	{0x1F260, 0x1F265, "Common"},
+	{0x1F300, 0x1F6D7, "Common"},
+	{0x1F6DD, 0x1F6EC, "Common"},
+	{0x1F6F0, 0x1F6FC, "Common"},
+	{0x1F700, 0x1F773, "Common"},
+	{0x1F780, 0x1F7D8, "Common"},
+	{0x1F7E0, 0x1F7EB, "Common"},
+	{0x1F7F0, 0x1F7F0, "Common"},
+	{0x1F800, 0x1F80B, "Common"},
+	{0x1F810, 0x1F847, "Common"},
+	{0x1F850, 0x1F859, "Common"},
+	{0x1F860, 0x1F887, "Common"},
+	{0x1F890, 0x1F8AD, "Common"},
+	{0x1F8B0, 0x1F8B1, "Common"},
+	{0x1F900, 0x1FA53, "Common"},
+	{0x1FA60, 0x1FA6D, "Common"},
+	{0x1FA70, 0x1FA74, "Common"},
+	{0x1FA78, 0x1FA7C, "Common"},
+	{0x1FA80, 0x1FA86, "Common"},
+	{0x1FA90, 0x1FAAC, "Common"},
+	{0x1FAB0, 0x1FABA, "Common"},
+	{0x1FAC0, 0x1FAC5, "Common"},
+	{0x1FAD0, 0x1FAD9, "Common"},
+	{0x1FAE0, 0x1FAE7, "Common"},
+	{0x1FAF0, 0x1FAF6, "Common"},
+	{0x1FB00, 0x1FB92, "Common"},
+	{0x1FB94, 0x1FBCA, "Common"},
+	{0x1FBF0, 0x1FBF9, "Common"},
+	{0x20000, 0x2A6DF, "Han"},
+	{0x2A700, 0x2B738, "Han"},
+	{0x2B740, 0x2B81D, "Han"},
+	{0x2B820, 0x2CEA1, "Han"},
+	{0x2CEB0, 0x2EBE0, "Han"},
+	{0x2F800, 0x2FA1D, "Han"},
+	{0x30000, 0x3134A, "Han"},
+	{0xE0001, 0xE0001, "Common"},
+	{0xE0020, 0xE007F, "Common"},
+	{0xE0100, 0xE01EF, "Inherited"},
+}
+
+// eastAsianWidths are the East_Asian_Width values of characters.
+var eastAsianWidths = []span{
+	{0x0020, 0x007E, "Na"},
+	{0x00A1, 0x00A1, "A"},
+	{0x00A2, 0x00A3, "Na"},
+	{0x00A4, 0x00A4, "A"},
+	{0x00A5, 0x00A6, "Na"},
+	{0x00A7, 0x00A8, "A"},
+	{0x00AA, 0x00AA, "A"},
+	{0x00AC, 0x00AC, "Na"},
+	{0x00AD, 0x00AE, "A"},
+	{0x00AF, 0x00AF, "Na"},
+	{0x00B0, 0x00B4, "A"},
+	{0x00B6, 0x00BA, "A"},
+	{0x00BC, 0x00BF, "A"},
+	{0x00C6, 0x00C6, "A"},
+	{0x00D0, 0x00D0, "A"},
+	{0x00D7, 0x00D8, "A"},
+	{0x00DE, 0x00E1, "A"},
+	{0x00E6, 0x00E6, "A"},
+	{0x00E8, 0x00EA, "A"},
+	{0x00EC, 0x00ED, "A"},
+	{0x00F0, 0x00F0, "A"},
+	{0x00F2, 0x00F3, "A"},
+	{0x00F7, 0x00FA, "A"},
+	{0x00FC, 0x00FC, "A"},
+	{0x00FE, 0x00FE, "A"},
+	{0x0101, 0x0101, "A"},
+	{0x0111, 0x0111, "A"},
+	{0x0113, 0x0113, "A"},
+	{0x011B, 0x011B, "A"},
+	{0x0126, 0x0127, "A"},
+	{0x012B, 0x012B, "A"},
+	{0x0131, 0x0133, "A"},
+	{0x0138, 0x0138, "A"},
+	{0x013F, 0x0142, "A"},
+	{0x0144, 0x0144, "A"},
+	{0x0148, 0x014B, "A"},
+	{0x014D, 0x014D, "A"},
+	{0x0152, 0x0153, "A"},
+	{0x0166, 0x0167, "A"},
+	{0x016B, 0x016B, "A"},
+	{0x01CE, 0x01CE, "A"},
+	{0x01D0, 0x01D0, "A"},
+	{0x01D2, 0x01D2, "A"},
+	{0x01D4, 0x01D4, "A"},
+	{0x01D6, 0x01D6, "A"},
+	{0x01D8, 0x01D8, "A"},
+	{0x01DA, 0x01DA, "A"},
+	{0x01DC, 0x01DC, "A"},
+	{0x0251, 0x0251, "A"},
+	{0x0261, 0x0261, "A"},
+	{0x02C4, 0x02C4, "A"},
+	{0x02C7, 0x02C7, "A"},
+	{0x02C9, 0x02CB, "A"},
+	{0x02CD, 0x02CD, "A"},
+	{0x02D0, 0x02D0, "A"},
+	{0x02D8, 0x02DB, "A"},
+	{0x02DD, 0x02DD, "A"},
+	{0x02DF, 0x02DF, "A"},
+	{0x0300, 0x036F, "A"},
+	{0x0391, 0x03A1, "A"},
+	{0x03A3, 0x03A9, "A"},
+	{0x03B1, 0x03C1, "A"},
+	{0x03C3, 0x03C9, "A"},
+	{0x0401, 0x0401, "A"},
+	{0x0410, 0x044F, "A"},
+	{0x0451, 0x0451, "A"},
+	{0x1100, 0x115F, "W"},
+	{0x2010, 0x2010, "A"},
+	{0x2013, 0x2016, "A"},
+	{0x2018, 0x2019, "A"},
+	{0x201C, 0x201D, "A"},
+	{0x2020, 0x2022, "A"},
+	{0x2024, 0x2027, "A"},
+	{0x2030, 0x2030, "A"},
+	{0x2032, 0x2033, "A"},
+	{0x2035, 0x2035, "A"},
+	{0x203B, 0x203B, "A"},
+	{0x203E, 0x203E, "A"},
+	{0x2074, 0x2074, "A"},
+	{0x207F, 0x207F, "A"},
+	{0x2081, 0x2084, "A"},
+	{0x20A9, 0x20A9, "H"},
+	{0x20AC, 0x20AC, "A"},
+	{0x2103, 0x2103, "A"},
+	{0x2105, 0x2105, "A"},
+	{0x2109, 0x2109, "A"},
+	{0x2113, 0x2113, "A"},
+	{0x2116, 0x2116, "A"},
+	{0x2121, 0x2122, "A"},
+	{0x2126, 0x2126, "A"},
+	{0x212B, 0x212B, "A"},
+	{0x2153, 0x2154, "A"},
+	{0x215B, 0x215E, "A"},
+	{0x2160, 0x216B, "A"},
+	{0x2170, 0x2179, "A"},
+	{0x2189, 0x2189, "A"},
+	{0x2190, 0x2199, "A"},
+	{0x21B8, 0x21B9, "A"},
+	{0x21D2, 0x21D2, "A"},
+	{0x21D4, 0x21D4, "A"},
+	{0x21E7, 0x21E7, "A"},
+	{0x2200, 0x2200, "A"},
+	{0x2202, 0x2203, "A"},
+	{0x2207, 0x2208, "A"},
+	{0x220B, 0x220B, "A"},
+	{0x220F, 0x220F, "A"},
+	{0x2211, 0x2211, "A"},
+	{0x2215, 0x2215, "A"},
+	{0x221A, 0x221A, "A"},
+	{0x221D, 0x2220, "A"},
+	{0x2223, 0x2223, "A"},
+	{0x2225, 0x2225, "A"},
+	{0x2227, 0x222C, "A"},
+	{0x222E, 0x222E, "A"},
+	{0x2234, 0x2237, "A"},
+	{0x223C, 0x223D, "A"},
+	{0x2248, 0x2248, "A"},
+	{0x224C, 0x224C, "A"},
+	{0x2252, 0x2252, "A"},
+	{0x2260, 0x2261, "A"},
+	{0x2264, 0x2267, "A"},
+	{0x226A, 0x226B, "A"},
+	{0x226E, 0x226F, "A"},
+	{0x2282, 0x2283, "A"},
+	{0x2286, 0x2287, "A"},
+	{0x2295, 0x2295, "A"},
+	{0x2299, 0x2299, "A"},
+	{0x22A5, 0x22A5, "A"},
+	{0x22BF, 0x22BF, "A"},
+	{0x2312, 0x2312, "A"},
+	{0x231A, 0x231B, "W"},
+	{0x2329, 0x232A, "W"},
+	{0x23E9, 0x23EC, "W"},
+	{0x23F0, 0x23F0, "W"},
+	{0x23F3, 0x23F3, "W"},
+	{0x2460, 0x24E9, "A"},
+	{0x24EB, 0x254B, "A"},
+	{0x2550, 0x2573, "A"},
+	{0x2580, 0x258F, "A"},
+	{0x2592, 0x2595, "A"},
+	{0x25A0, 0x25A1, "A"},
+	{0x25A3, 0x25A9, "A"},
+	{0x25B2, 0x25B3, "A"},
+	{0x25B6, 0x25B7, "A"},
+	{0x25BC, 0x25BD, "A"},
+	{0x25C0, 0x25C1, "A"},
+	{0x25C6, 0x25C8, "A"},
+	{0x25CB, 0x25CB, "A"},
+	{0x25CE, 0x25D1, "A"},
+	{0x25E2, 0x25E5, "A"},
+	{0x25EF, 0x25EF, "A"},
+	{0x25FD, 0x25FE, "W"},
+	{0x2605, 0x2606, "A"},
+	{0x2609, 0x2609, "A"},
+	{0x260E, 0x260F, "A"},
+	{0x2614, 0x2615, "W"},
+	{0x261C, 0x261C, "A"},
+	{0x261E, 0x261E, "A"},
+	{0x2640, 0x2640, "A"},
+	{0x2642, 0x2642, "A"},
+	{0x2648, 0x2653, "W"},
+	{0x2660, 0x2661, "A"},
+	{0x2663, 0x2665, "A"},
+	{0x2667, 0x266A, "A"},
+	{0x266C, 0x266D, "A"},
+	{0x266F, 0x266F, "A"},
+	{0x267F, 0x267F, "W"},
+	{0x2693, 0x2693, "W"},
+	{0x269E, 0x269F, "A"},
+	{0x26A1, 0x26A1, "W"},
+	{0x26AA, 0x26AB, "W"},
+	{0x26BD, 0x26BE, "W"},
+	{0x26BF, 0x26BF, "A"},
+	{0x26C4, 0x26C5, "W"},
+	{0x26C6, 0x26CD, "A"},
+	{0x26CE, 0x26CE, "W"},
+	{0x26CF, 0x26D3, "A"},
+	{0x26D4, 0x26D4, "W"},
+	{0x26D5, 0x26E1, "A"},
+	{0x26E3, 0x26E3, "A"},
+	{0x26E8, 0x26E9, "A"},
+	{0x26EA, 0x26EA, "W"},
+	{0x26EB, 0x26F1, "A"},
+	{0x26F2, 0x26F3, "W"},
+	{0x26F4, 0x26F4, "A"},
+	{0x26F5, 0x26F5, "W"},
+	{0x26F6, 0x26F9, "A"},
+	{0x26FA, 0x26FA, "W"},
+	{0x26FB, 0x26FC, "A"},
+	{0x26FD, 0x26FD, "W"},
+	{0x26FE, 0x26FF, "A"},
+	{0x2705, 0x2705, "W"},
+	{0x270A, 0x270B, "W"},
+	{0x2728, 0x2728, "W"},
+	{0x273D, 0x273D, "A"},
+	{0x274C, 0x274C, "W"},
+	{0x274E, 0x274E, "W"},
+	{0x2753, 0x2755, "W"},
+	{0x2757, 0x2757, "W"},
+	{0x2776, 0x277F, "A"},
+	{0x2795, 0x2797, "W"},
+	{0x27B0, 0x27B0, "W"},
+	{0x27BF, 0x27BF, "W"},
+	{0x27E6, 0x27ED, "Na"},
+	{0x2985, 0x2986, "Na"},
+	{0x2B1B, 0x2B1C, "W"},
+	{0x2B50, 0x2B50, "W"},
+	{0x2B55, 0x2B55, "W"},
+	{0x2B56, 0x2B59, "A"},
+	{0x2E80, 0x2E99, "W"},
+	{0x2E9B, 0x2EF3, "W"},
+	{0x2F00, 0x2FD5, "W"},
+	{0x2FF0, 0x2FFB, "W"},
+	{0x3000, 0x3000, "F"},
+	{0x3001, 0x303E, "W"},
+	{0x3041, 0x3096, "W"},
+	{0x3099, 0x30FF, "W"},
+	{0x3105, 0x312F, "W"},
+	{0x3131, 0x318E, "W"},
+	{0x3190, 0x31E3, "W"},
+	{0x31F0, 0x321E, "W"},
+	{0x3220, 0x3247, "W"},
+	{0x3248, 0x324F, "A"},
+	{0x3250, 0x4DBF, "W"},
+	{0x4E00, 0xA48C, "W"},
+	{0xA490, 0xA4C6, "W"},
+	{0xA960, 0xA97C, "W"},
+	{0xAC00, 0xD7A3, "W"},
+	{0xE000, 0xF8FF, "A"},
+	{0xF900, 0xFAFF, "W"},
+	{0xFE00, 0xFE0F, "A"},
+	{0xFE10, 0xFE19, "W"},
+	{0xFE30, 0xFE52, "W"},
+	{0xFE54, 0xFE66, "W"},
+	{0xFE68, 0xFE6B, "W"},
+	{0xFF01, 0xFF60, "F"},
+	{0xFF61, 0xFFBE, "H"},
+	{0xFFC2, 0xFFC7, "H"},
+	{0xFFCA, 0xFFCF, "H"},
+	{0xFFD2, 0xFFD7, "H"},
+	{0xFFDA, 0xFFDC, "H"},
+	{0xFFE0, 0xFFE6, "F"},
+	{0xFFE8, 0xFFEE, "H"},
+	{0xFFFD, 0xFFFD, "A"},
+	{0x16FE0, 0x16FE4, "W"},
+	{0x16FF0, 0x16FF1, "W"},
+	{0x17000, 0x187F7, "W"},
+	{0x18800, 0x18CD5, "W"},
+	{0x18D00, 0x18D08, "W"},
+	{0x1AFF0, 0x1AFF3, "W"},
+	{0x1AFF5, 0x1AFFB, "W"},
+	{0x1AFFD, 0x1AFFE, "W"},
+	{0x1B000, 0x1B122, "W"},
+	{0x1B150, 0x1B152, "W"},
+	{0x1B164, 0x1B167, "W"},
+	{0x1B170, 0x1B2FB, "W"},
+	{0x1F004, 0x1F004, "W"},
+	{0x1F0CF, 0x1F0CF, "W"},
+	{0x1F100, 0x1F10A, "A"},
+	{0x1F110, 0x1F12D, "A"},
+	{0x1F130, 0x1F169, "A"},
+	{0x1F170, 0x1F18D, "A"},
+	{0x1F18E, 0x1F18E, "W"},
+	{0x1F18F, 0x1F190, "A"},
+	{0x1F191, 0x1F19A, "W"},
+	{0x1F19B, 0x1F1AC, "A"},
+	{0x1F200, 0x1F202, "W"},
+	{0x1F210, 0x1F23B, "W"},
+	{0x1F240, 0x1F248, "W"},
+	{0x1F250, 0x1F251, "W"},
+	{0x1F260, 0x1F265, "W"},
+	{0x1F300, 0x1F320, "W"},
+	{0x1F32D, 0x1F335, "W"},
+	{0x1F337, 0x1F37C, "W"},
+	{0x1F37E, 0x1F393, "W"},
+	{0x1F3A0, 0x1F3CA, "W"},
+	{0x1F3CF, 0x1F3D3, "W"},
+	{0x1F3E0, 0x1F3F0, "W"},
+	{0x1F3F4, 0x1F3F4, "W"},
+	{0x1F3F8, 0x1F43E, "W"},
+	{0x1F440, 0x1F440, "W"},
+	{0x1F442, 0x1F4FC, "W"},
+	{0x1F4FF, 0x1F53D, "W"},
+	{0x1F54B, 0x1F54E, "W"},
+	{0x1F550, 0x1F567, "W"},
+	{0x1F57A, 0x1F57A, "W"},
+	{0x1F595, 0x1F596, "W"},
+	{0x1F5A4, 0x1F5A4, "W"},
+	{0x1F5FB, 0x1F64F, "W"},
+	{0x1F680, 0x1F6C5, "W"},
+	{0x1F6CC, 0x1F6CC, "W"},
+	{0x1F6D0, 0x1F6D2, "W"},
+	{0x1F6D5, 0x1F6D7, "W"},
+	{0x1F6DD, 0x1F6DF, "W"},
+	{0x1F6EB, 0x1F6EC, "W"},
+	{0x1F6F4, 0x1F6FC, "W"},
+	{0x1F7E0, 0x1F7EB, "W"},
+	{0x1F7F0, 0x1F7F0, "W"},
+	{0x1F90C, 0x1F93A, "W"},
+	{0x1F93C, 0x1F945, "W"},
+	{0x1F947, 0x1F9FF, "W"},
+	{0x1FA70, 0x1FA74, "W"},
+	{0x1FA78, 0x1FA7C, "W"},
+	{0x1FA80, 0x1FA86, "W"},
+	{0x1FA90, 0x1FAAC, "W"},
+	{0x1FAB0, 0x1FABA, "W"},
+	{0x1FAC0, 0x1FAC5, "W"},
+	{0x1FAD0, 0x1FAD9, "W"},
+	{0x1FAE0, 0x1FAE7, "W"},
+	{0x1FAF0, 0x1FAF6, "W"},
+	{0x20000, 0x2FFFD, "W"},
+	{0x30000, 0x3FFFD, "W"},
+	{0xE0100, 0xE01EF, "A"},
+	{0xF0000, 0xFFFFD, "A"},
+	{0x100000, 0x10FFFD, "A"},
+}
+
+// ages are the versions of Unicode in which characters were assigned.
+var ages = []span{
+	{0x0000, 0x01F5, "1.1"},
+	{0x01F6, 0x01F9, "3.0"},
+	{0x01FA, 0x0217, "1.1"},
+	{0x0218, 0x021F, "3.0"},
+	{0x0220, 0x0220, "3.2"},
+	{0x0221, 0x0221, "4.0"},
+	{0x0222, 0x0233, "3.0"},
+	{0x0234, 0x0236, "4.0"},
+	{0x0237, 0x0241, "4.1"},
+	{0x0242, 0x024F, "5.0"},
+	{0x0250, 0x02A8, "1.1"},
+	{0x02A9, 0x02AD, "3.0"},
+	{0x02AE, 0x02AF, "4.0"},
+	{0x02B0, 0x02DE, "1.1"},
+	{0x02DF, 0x02DF, "3.0"},
+	{0x02E0, 0x02E9, "1.1"},
+	{0x02EA, 0x02EE, "3.0"},
+	{0x02EF, 0x02FF, "4.0"},
+	{0x0300, 0x0345, "1.1"},
+	{0x0346, 0x034E, "3.0"},
+	{0x034F, 0x034F, "3.2"},
+	{0x0350, 0x0357, "4.0"},
+	{0x0358, 0x035C, "4.1"},
+	{0x035D, 0x035F, "4.0"},
+	{0x0360, 0x0361, "1.1"},
+	{0x0362, 0x0362, "3.0"},
+	{0x0363, 0x036F, "3.2"},
+	{0x0370, 0x0373, "5.1"},
+	{0x0374, 0x0375, "1.1"},
+	{0x0376, 0x0377, "5.1"},
+	{0x037A, 0x037A, "1.1"},
+	{0x037B, 0x037D, "5.0"},
+	{0x037E, 0x037E, "1.1"},
+	{0x037F, 0x037F, "7.0"},
+	{0x0384, 0x038A, "1.1"},
+	{0x038C, 0x038C, "1.1"},
+	{0x038E, 0x03A1, "1.1"},
+	{0x03A3, 0x03CE, "1.1"},
+	{0x03CF, 0x03CF, "5.1"},
+	{0x03D0, 0x03D6, "1.1"},
+	{0x03D7, 0x03D7, "3.0"},
+	{0x03D8, 0x03D9, "3.2"},
+	{0x03DA, 0x03DA, "1.1"},
+	{0x03DB, 0x03DB, "3.0"},
+	{0x03DC, 0x03DC, "1.1"},
+	{0x03DD, 0x03DD, "3.0"},
+	{0x03DE, 0x03DE, "1.1"},
+	{0x03DF, 0x03DF, "3.0"},
+	{0x03E0, 0x03E0, "1.1"},
+	{0x03E1, 0x03E1, "3.0"},
+	{0x03E2, 0x03F3, "1.1"},
+	{0x03F4, 0x03F5, "3.1"},
+	{0x03F6, 0x03F6, "3.2"},
+	{0x03F7, 0x03FB, "4.0"},
+	{0x03FC, 0x03FF, "4.1"},
+	{0x0400, 0x0400, "3.0"},
+	{0x0401, 0x040C, "1.1"},
+	{0x040D, 0x040D, "3.0"},
+	{0x040E, 0x044F, "1.1"},
+	{0x0450, 0x0450, "3.0"},
+	{0x0451, 0x045C, "1.1"},
+	{0x045D, 0x045D, "3.0"},
+	{0x045E, 0x0486, "1.1"},
+	{0x0487, 0x0487, "5.1"},
+	{0x0488, 0x0489, "3.0"},
+	{0x048A, 0x048B, "3.2"},
+	{0x048C, 0x048F, "3.0"},
+	{0x0490, 0x04C4, "1.1"},
+	{0x04C5, 0x04C6, "3.2"},
+	{0x04C7, 0x04C8, "1.1"},
+	{0x04C9, 0x04CA, "3.2"},
+	{0x04CB, 0x04CC, "1.1"},
+	{0x04CD, 0x04CE, "3.2"},
+	{0x04CF, 0x04CF, "5.0"},
+	{0x04D0, 0x04EB, "1.1"},
+	{0x04EC, 0x04ED, "3.0"},
+	{0x04EE, 0x04F5, "1.1"},
+	{0x04F6, 0x04F7, "4.1"},
+	{0x04F8, 0x04F9, "1.1"},
+	{0x04FA, 0x04FF, "5.0"},
+	{0x0500, 0x050F, "3.2"},
+	{0x0510, 0x0513, "5.0"},
+	{0x0514, 0x0523, "5.1"},
+	{0x0524, 0x0525, "5.2"},
+	{0x0526, 0x0527, "6.0"},
+	{0x0528, 0x052F, "7.0"},
+	{0x0531, 0x0556, "1.1"},
+	{0x0559, 0x055F, "1.1"},
+	{0x0560, 0x0560, "11.0"},
+	{0x0561, 0x0587, "1.1"},
+	{0x0588, 0x0588, "11.0"},
+	{0x0589, 0x0589, "1.1"},
+	{0x058A, 0x058A, "3.0"},
+	{0x058D, 0x058E, "7.0"},
+	{0x058F, 0x058F, "6.1"},
+	{0x0591, 0x05A1, "2.0"},
+	{0x05A2, 0x05A2, "4.1"},
+	{0x05A3, 0x05AF, "2.0"},
+	{0x05B0, 0x05B9, "1.1"},
+	{0x05BA, 0x05BA, "5.0"},
+	{0x05BB, 0x05C3, "1.1"},
+	{0x05C4, 0x05C4, "2.0"},
+	{0x05C5, 0x05C7, "4.1"},
+	{0x05D0, 0x05EA, "1.1"},
+	{0x05EF, 0x05EF, "11.0"},
+	{0x05F0, 0x05F4, "1.1"},
+	{0x0600, 0x0603, "4.0"},
+	{0x0604, 0x0604, "6.1"},
+	{0x0605, 0x0605, "7.0"},
+	{0x0606, 0x060A, "5.1"},
+	{0x060B, 0x060B, "4.1"},
+	{0x060C, 0x060C, "1.1"},
+	{0x060D, 0x0615, "4.0"},
+	{0x0616, 0x061A, "5.1"},
+	{0x061B, 0x061B, "1.1"},
+	{0x061C, 0x061C, "6.3"},
+	{0x061D, 0x061D, "14.0"},
+	{0x061E, 0x061E, "4.1"},
+	{0x061F, 0x061F, "1.1"},
+	{0x0620, 0x0620, "6.0"},
+	{0x0621, 0x063A, "1.1"},
+	{0x063B, 0x063F, "5.1"},
+	{0x0640, 0x0652, "1.1"},
+	{0x0653, 0x0655, "3.0"},
+	{0x0656, 0x0658, "4.0"},
+	{0x0659, 0x065E, "4.1"},
+	{0x065F, 0x065F, "6.0"},
+	{0x0660, 0x066D, "1.1"},
+	{0x066E, 0x066F, "3.2"},
+	{0x0670, 0x06B7, "1.1"},
+	{0x06B8, 0x06B9, "3.0"},
+	{0x06BA, 0x06BE, "1.1"},
+	{0x06BF, 0x06BF, "3.0"},
+	{0x06C0, 0x06CE, "1.1"},
+	{0x06CF, 0x06CF, "3.0"},
+	{0x06D0, 0x06ED, "1.1"},
+	{0x06EE, 0x06EF, "4.0"},
+	{0x06F0, 0x06F9, "1.1"},
+	{0x06FA, 0x06FE, "3.0"},
+	{0x06FF, 0x06FF, "4.0"},
+	{0x0700, 0x070D, "3.0"},
+	{0x070F, 0x072C, "3.0"},
+	{0x072D, 0x072F, "4.0"},
+	{0x0730, 0x074A, "3.0"},
+	{0x074D, 0x074F, "4.0"},
+	{0x0750, 0x076D, "4.1"},
+	{0x076E, 0x077F, "5.1"},
+	{0x0780, 0x07B0, "3.0"},
+	{0x07B1, 0x07B1, "3.2"},
+	{0x07C0, 0x07FA, "5.0"},
+	{0x07FD, 0x07FF, "11.0"},
+	{0x0800, 0x082D, "5.2"},
+	{0x0830, 0x083E, "5.2"},
+	{0x0840, 0x085B, "6.0"},
+	{0x085E, 0x085E, "6.0"},
+	{0x0860, 0x086A, "10.0"},
+	{0x0870, 0x088E, "14.0"},
+	{0x0890, 0x0891, "14.0"},
+	{0x0898, 0x089F, "14.0"},
+	{0x08A0, 0x08A0, "6.1"},
+	{0x08A1, 0x08A1, "7.0"},
+	{0x08A2, 0x08AC, "6.1"},
+	{0x08AD, 0x08B2, "7.0"},
+	{0x08B3, 0x08B4, "8.0"},
+	{0x08B5, 0x08B5, "14.0"},
+	{0x08B6, 0x08BD, "9.0"},
+	{0x08BE, 0x08C7, "13.0"},
+	{0x08C8, 0x08D2, "14.0"},
+	{0x08D3, 0x08D3, "11.0"},
+	{0x08D4, 0x08E2, "9.0"},
+	{0x08E3, 0x08E3, "8.0"},
+	{0x08E4, 0x08FE, "6.1"},
+	{0x08FF, 0x08FF, "7.0"},
+	{0x0900, 0x0900, "5.2"},
+	{0x0901, 0x0903, "1.1"},
+	{0x0904, 0x0904, "4.0"},
+	{0x0905, 0x0939, "1.1"},
+	{0x093A, 0x093B, "6.0"},
+	{0x093C, 0x094D, "1.1"},
+	{0x094E, 0x094E, "5.2"},
+	{0x094F, 0x094F, "6.0"},
+	{0x0950, 0x0954, "1.1"},
+	{0x0955, 0x0955, "5.2"},
+	{0x0956, 0x0957, "6.0"},
+	{0x0958, 0x0970, "1.1"},
+	{0x0971, 0x0972, "5.1"},
+	{0x0973, 0x0977, "6.0"},
+	{0x0978, 0x0978, "7.0"},
+	{0x0979, 0x097A, "5.2"},
+	{0x097B, 0x097C, "5.0"},
+	{0x097D, 0x097D, "4.1"},
+	{0x097E, 0x097F, "5.0"},
+	{0x0980, 0x0980, "7.0"},
+	{0x0981, 0x0983, "1.1"},
+	{0x0985, 0x098C, "1.1"},
+	{0x098F, 0x0990, "1.1"},
+	{0x0993, 0x09A8, "1.1"},
+	{0x09AA, 0x09B0, "1.1"},
+	{0x09B2, 0x09B2, "1.1"},
+	{0x09B6, 0x09B9, "1.1"},
+	{0x09BC, 0x09BC, "1.1"},
+	{0x09BD, 0x09BD, "4.0"},
+	{0x09BE, 0x09C4, "1.1"},
+	{0x09C7, 0x09C8, "1.1"},
+	{0x09CB, 0x09CD, "1.1"},
+	{0x09CE, 0x09CE, "4.1"},
+	{0x09D7, 0x09D7, "1.1"},
+	{0x09DC, 0x09DD, "1.1"},
+	{0x09DF, 0x09E3, "1.1"},
+	{0x09E6, 0x09FA, "1.1"},
+	{0x09FB, 0x09FB, "5.2"},
+	{0x09FC, 0x09FD, "10.0"},
+	{0x09FE, 0x09FE, "11.0"},
+	{0x0A01, 0x0A01, "4.0"},
+	{0x0A02, 0x0A02, "1.1"},
+	{0x0A03, 0x0A03, "4.0"},
+	{0x0A05, 0x0A0A, "1.1"},
+	{0x0A0F, 0x0A10, "1.1"},
+	{0x0A13, 0x0A28, "1.1"},
+	{0x0A2A, 0x0A30, "1.1"},
+	{0x0A32, 0x0A33, "1.1"},
+	{0x0A35, 0x0A36, "1.1"},
+	{0x0A38, 0x0A39, "1.1"},
+	{0x0A3C, 0x0A3C, "1.1"},
+	{0x0A3E, 0x0A42, "1.1"},
+	{0x0A47, 0x0A48, "1.1"},
+	{0x0A4B, 0x0A4D, "1.1"},
+	{0x0A51, 0x0A51, "5.1"},
+	{0x0A59, 0x0A5C, "1.1"},
+	{0x0A5E, 0x0A5E, "1.1"},
+	{0x0A66, 0x0A74, "1.1"},
+	{0x0A75, 0x0A75, "5.1"},
+	{0x0A76, 0x0A76, "11.0"},
+	{0x0A81, 0x0A83, "1.1"},
+	{0x0A85, 0x0A8B, "1.1"},
+	{0x0A8C, 0x0A8C, "4.0"},
+	{0x0A8D, 0x0A8D, "1.1"},
+	{0x0A8F, 0x0A91, "1.1"},
+	{0x0A93, 0x0AA8, "1.1"},
+	{0x0AAA, 0x0AB0, "1.1"},
+	{0x0AB2, 0x0AB3, "1.1"},
+	{0x0AB5, 0x0AB9, "1.1"},
+	{0x0ABC, 0x0AC5, "1.1"},
+	{0x0AC7, 0x0AC9, "1.1"},
+	{0x0ACB, 0x0ACD, "1.1"},
+	{0x0AD0, 0x0AD0, "1.1"},
+	{0x0AE0, 0x0AE0, "1.1"},
+	{0x0AE1, 0x0AE3, "4.0"},
+	{0x0AE6, 0x0AEF, "1.1"},
+	{0x0AF0, 0x0AF0, "6.1"},
+	{0x0AF1, 0x0AF1, "4.0"},
+	{0x0AF9, 0x0AF9, "8.0"},
+	{0x0AFA, 0x0AFF, "10.0"},
+	{0x0B01, 0x0B03, "1.1"},
+	{0x0B05, 0x0B0C, "1.1"},
+	{0x0B0F, 0x0B10, "1.1"},
+	{0x0B13, 0x0B28, "1.1"},
+	{0x0B2A, 0x0B30, "1.1"},
+	{0x0B32, 0x0B33, "1.1"},
+	{0x0B35, 0x0B35, "4.0"},
+	{0x0B36, 0x0B39, "1.1"},
+	{0x0B3C, 0x0B43, "1.1"},
+	{0x0B44, 0x0B44, "5.1"},
+	{0x0B47, 0x0B48, "1.1"},
+	{0x0B4B, 0x0B4D, "1.1"},
+	{0x0B55, 0x0B55, "13.0"},
+	{0x0B56, 0x0B57, "1.1"},
+	{0x0B5C, 0x0B5D, "1.1"},
+	{0x0B5F, 0x0B61, "1.1"},
+	{0x0B62, 0x0B63, "5.1"},
+	{0x0B66, 0x0B70, "1.1"},
+	{0x0B71, 0x0B71, "4.0"},
+	{0x0B72, 0x0B77, "6.0"},
+	{0x0B82, 0x0B83, "1.1"},
+	{0x0B85, 0x0B8A, "1.1"},
+	{0x0B8E, 0x0B90, "1.1"},
+	{0x0B92, 0x0B95, "1.1"},
+	{0x0B99, 0x0B9A, "1.1"},
+	{0x0B9C, 0x0B9C, "1.1"},
+	{0x0B9E, 0x0B9F, "1.1"},
+	{0x0BA3, 0x0BA4, "1.1"},
+	{0x0BA8, 0x0BAA, "1.1"},
+	{0x0BAE, 0x0BB5, "1.1"},
+	{0x0BB6, 0x0BB6, "4.1"},
+	{0x0BB7, 0x0BB9, "1.1"},
+	{0x0BBE, 0x0BC2, "1.1"},
+	{0x0BC6, 0x0BC8, "1.1"},
+	{0x0BCA, 0x0BCD, "1.1"},
+	{0x0BD0, 0x0BD0, "5.1"},
+	{0x0BD7, 0x0BD7, "1.1"},
+	{0x0BE6, 0x0BE6, "4.1"},
+	{0x0BE7, 0x0BF2, "1.1"},
+	{0x0BF3, 0x0BFA, "4.0"},
+	{0x0C00, 0x0C00, "7.0"},
+	{0x0C01, 0x0C03, "1.1"},
+	{0x0C04, 0x0C04, "11.0"},
+	{0x0C05, 0x0C0C, "1.1"},
+	{0x0C0E, 0x0C10, "1.1"},
+	{0x0C12, 0x0C28, "1.1"},
+	{0x0C2A, 0x0C33, "1.1"},
+	{0x0C34, 0x0C34, "7.0"},
+	{0x0C35, 0x0C39, "1.1"},
+	{0x0C3C, 0x0C3C, "14.0"},
+	{0x0C3D, 0x0C3D, "5.1"},
+	{0x0C3E, 0x0C44, "1.1"},
+	{0x0C46, 0x0C48, "1.1"},
+	{0x0C4A, 0x0C4D, "1.1"},
+	{0x0C55, 0x0C56, "1.1"},
+	{0x0C58, 0x0C59, "5.1"},
+	{0x0C5A, 0x0C5A, "8.0"},
+	{0x0C5D, 0x0C5D, "14.0"},
+	{0x0C60, 0x0C61, "1.1"},
+	{0x0C62, 0x0C63, "5.1"},
+	{0x0C66, 0x0C6F, "1.1"},
+	{0x0C77, 0x0C77, "12.0"},
+	{0x0C78, 0x0C7F, "5.1"},
+	{0x0C80, 0x0C80, "9.0"},
+	{0x0C81, 0x0C81, "7.0"},
+	{0x0C82, 0x0C83, "1.1"},
+	{0x0C84, 0x0C84, "11.0"},
+	{0x0C85, 0x0C8C, "1.1"},
+	{0x0C8E, 0x0C90, "1.1"},
+	{0x0C92, 0x0CA8, "1.1"},
+	{0x0CAA, 0x0CB3, "1.1"},
+	{0x0CB5, 0x0CB9, "1.1"},
+	{0x0CBC, 0x0CBD, "4.0"},
+	{0x0CBE, 0x0CC4, "1.1"},
+	{0x0CC6, 0x0CC8, "1.1"},
+	{0x0CCA, 0x0CCD, "1.1"},
+	{0x0CD5, 0x0CD6, "1.1"},
+	{0x0CDD, 0x0CDD, "14.0"},
+	{0x0CDE, 0x0CDE, "1.1"},
+	{0x0CE0, 0x0CE1, "1.1"},
+	{0x0CE2, 0x0CE3, "5.0"},
+	{0x0CE6, 0x0CEF, "1.1"},
+	{0x0CF1, 0x0CF2, "5.0"},
+	{0x0D00, 0x0D00, "10.0"},
+	{0x0D01, 0x0D01, "7.0"},
+	{0x0D02, 0x0D03, "1.1"},
+	{0x0D04, 0x0D04, "13.0"},
+	{0x0D05, 0x0D0C, "1.1"},
+	{0x0D0E, 0x0D10, "1.1"},
+	{0x0D12, 0x0D28, "1.1"},
+	{0x0D29, 0x0D29, "6.0"},
+	{0x0D2A, 0x0D39, "1.1"},
+	{0x0D3A, 0x0D3A, "6.0"},
+	{0x0D3B, 0x0D3C, "10.0"},
+	{0x0D3D, 0x0D3D, "5.1"},
+	{0x0D3E, 0x0D43, "1.1"},
+	{0x0D44, 0x0D44, "5.1"},
+	{0x0D46, 0x0D48, "1.1"},
+	{0x0D4A, 0x0D4D, "1.1"},
+	{0x0D4E, 0x0D4E, "6.0"},
+	{0x0D4F, 0x0D4F, "9.0"},
+	{0x0D54, 0x0D56, "9.0"},
+	{0x0D57, 0x0D57, "1.1"},
+	{0x0D58, 0x0D5E, "9.0"},
+	{0x0D5F, 0x0D5F, "8.0"},
+	{0x0D60, 0x0D61, "1.1"},
+	{0x0D62, 0x0D63, "5.1"},
+	{0x0D66, 0x0D6F, "1.1"},
+	{0x0D70, 0x0D75, "5.1"},
+	{0x0D76, 0x0D78, "9.0"},
+	{0x0D79, 0x0D7F, "5.1"},
+	{0x0D81, 0x0D81, "13.0"},
+	{0x0D82, 0x0D83, "3.0"},
+	{0x0D85, 0x0D96, "3.0"},
+	{0x0D9A, 0x0DB1, "3.0"},
+	{0x0DB3, 0x0DBB, "3.0"},
+	{0x0DBD, 0x0DBD, "3.0"},
+	{0x0DC0, 0x0DC6, "3.0"},
+	{0x0DCA, 0x0DCA, "3.0"},
+	{0x0DCF, 0x0DD4, "3.0"},
+	{0x0DD6, 0x0DD6, "3.0"},
+	{0x0DD8, 0x0DDF, "3.0"},
+	{0x0DE6, 0x0DEF, "7.0"},
+	{0x0DF2, 0x0DF4, "3.0"},
+	{0x0E01, 0x0E3A, "1.1"},
+	{0x0E3F, 0x0E5B, "1.1"},
+	{0x0E81, 0x0E82, "1.1"},
+	{0x0E84, 0x0E84, "1.1"},
+	{0x0E86, 0x0E86, "12.0"},
+	{0x0E87, 0x0E88, "1.1"},
+	{0x0E89, 0x0E89, "12.0"},
+	{0x0E8A, 0x0E8A, "1.1"},
+	{0x0E8C, 0x0E8C, "12.0"},
+	{0x0E8D, 0x0E8D, "1.1"},
+	{0x0E8E, 0x0E93, "12.0"},
+	{0x0E94, 0x0E97, "1.1"},
+	{0x0E98, 0x0E98, "12.0"},
+	{0x0E99, 0x0E9F, "1.1"},
+	{0x0EA0, 0x0EA0, "12.0"},
+	{0x0EA1, 0x0EA3, "1.1"},
+	{0x0EA5, 0x0EA5, "1.1"},
+	{0x0EA7, 0x0EA7, "1.1"},
+	{0x0EA8, 0x0EA9, "12.0"},
+	{0x0EAA, 0x0EAB, "1.1"},
+	{0x0EAC, 0x0EAC, "12.0"},
+	{0x0EAD, 0x0EB9, "1.1"},
+	{0x0EBA, 0x0EBA, "12.0"},
+	{0x0EBB, 0x0EBD, "1.1"},
+	{0x0EC0, 0x0EC4, "1.1"},
+	{0x0EC6, 0x0EC6, "1.1"},
+	{0x0EC8, 0x0ECD, "1.1"},
+	{0x0ED0, 0x0ED9, "1.1"},
+	{0x0EDC, 0x0EDD, "1.1"},
+	{0x0EDE, 0x0EDF, "6.1"},
+	{0x0F00, 0x0F47, "2.0"},
+	{0x0F49, 0x0F69, "2.0"},
+	{0x0F6A, 0x0F6A, "3.0"},
+	{0x0F6B, 0x0F6C, "5.1"},
+	{0x0F71, 0x0F8B, "2.0"},
+	{0x0F8C, 0x0F8F, "6.0"},
+	{0x0F90, 0x0F95, "2.0"},
+	{0x0F96, 0x0F96, "3.0"},
+	{0x0F97, 0x0F97, "2.0"},
+	{0x0F99, 0x0FAD, "2.0"},
+	{0x0FAE, 0x0FB0, "3.0"},
+	{0x0FB1, 0x0FB7, "2.0"},
+	{0x0FB8, 0x0FB8, "3.0"},
+	{0x0FB9, 0x0FB9, "2.0"},
+	{0x0FBA, 0x0FBC, "3.0"},
+	{0x0FBE, 0x0FCC, "3.0"},
+	{0x0FCE, 0x0FCE, "5.1"},
+	{0x0FCF, 0x0FCF, "3.0"},
+	{0x0FD0, 0x0FD1, "4.1"},
+	{0x0FD2, 0x0FD4, "5.1"},
+	{0x0FD5, 0x0FD8, "5.2"},
+	{0x0FD9, 0x0FDA, "6.0"},
+	{0x1000, 0x1021, "3.0"},
+	{0x1022, 0x1022, "5.1"},
+	{0x1023, 0x1027, "3.0"},
+	{0x1028, 0x1028, "5.1"},
+	{0x1029, 0x102A, "3.0"},
+	{0x102B, 0x102B, "5.1"},
+	{0x102C, 0x1032, "3.0"},
+	{0x1033, 0x1035, "5.1"},
+	{0x1036, 0x1039, "3.0"},
+	{0x103A, 0x103F, "5.1"},
+	{0x1040, 0x1059, "3.0"},
+	{0x105A, 0x1099, "5.1"},
+	{0x109A, 0x109D, "5.2"},
+	{0x109E, 0x109F, "5.1"},
+	{0x10A0, 0x10C5, "1.1"},
+	{0x10C7, 0x10C7, "6.1"},
+	{0x10CD, 0x10CD, "6.1"},
+	{0x10D0, 0x10F6, "1.1"},
+	{0x10F7, 0x10F8, "3.2"},
+	{0x10F9, 0x10FA, "4.1"},
+	{0x10FB, 0x10FB, "1.1"},
+	{0x10FC, 0x10FC, "4.1"},
+	{0x10FD, 0x10FF, "6.1"},
+	{0x1100, 0x1159, "1.1"},
+	{0x115A, 0x115E, "5.2"},
+	{0x115F, 0x11A2, "1.1"},
+	{0x11A3, 0x11A7, "5.2"},
+	{0x11A8, 0x11F9, "1.1"},
+	{0x11FA, 0x11FF, "5.2"},
+	{0x1200, 0x1206, "3.0"},
+	{0x1207, 0x1207, "4.1"},
+	{0x1208, 0x1246, "3.0"},
+	{0x1247, 0x1247, "4.1"},
+	{0x1248, 0x1248, "3.0"},
+	{0x124A, 0x124D, "3.0"},
+	{0x1250, 0x1256, "3.0"},
+	{0x1258, 0x1258, "3.0"},
+	{0x125A, 0x125D, "3.0"},
+	{0x1260, 0x1286, "3.0"},
+	{0x1287, 0x1287, "4.1"},
+	{0x1288, 0x1288, "3.0"},
+	{0x128A, 0x128D, "3.0"},
+	{0x1290, 0x12AE, "3.0"},
+	{0x12AF, 0x12AF, "4.1"},
+	{0x12B0, 0x12B0, "3.0"},
+	{0x12B2, 0x12B5, "3.0"},
+	{0x12B8, 0x12BE, "3.0"},
+	{0x12C0, 0x12C0, "3.0"},
+	{0x12C2, 0x12C5, "3.0"},
+	{0x12C8, 0x12CE, "3.0"},
+	{0x12CF, 0x12CF, "4.1"},
+	{0x12D0, 0x12D6, "3.0"},
+	{0x12D8, 0x12EE, "3.0"},
+	{0x12EF, 0x12EF, "4.1"},
+	{0x12F0, 0x130E, "3.0"},
+	{0x130F, 0x130F, "4.1"},
+	{0x1310, 0x1310, "3.0"},
+	{0x1312, 0x1315, "3.0"},
+	{0x1318, 0x131E, "3.0"},
+	{0x131F, 0x131F, "4.1"},
+	{0x1320, 0x1346, "3.0"},
+	{0x1347, 0x1347, "4.1"},
+	{0x1348, 0x135A, "3.0"},
+	{0x135D, 0x135E, "6.0"},
+	{0x135F, 0x1360, "4.1"},
+	{0x1361, 0x137C, "3.0"},
+	{0x1380, 0x1399, "4.1"},
+	{0x13A0, 0x13F4, "3.0"},
+	{0x13F5, 0x13F5, "8.0"},
+	{0x13F8, 0x13FD, "8.0"},
+	{0x1400, 0x1400, "5.2"},
+	{0x1401, 0x1676, "3.0"},
+	{0x1677, 0x167F, "5.2"},
+	{0x1680, 0x169C, "3.0"},
+	{0x16A0, 0x16F0, "3.0"},
+	{0x16F1, 0x16F8, "7.0"},
+	{0x1700, 0x170C, "3.2"},
+	{0x170D, 0x170D, "14.0"},
+	{0x170E, 0x1714, "3.2"},
+	{0x1715, 0x1715, "14.0"},
+	{0x171F, 0x171F, "14.0"},
+	{0x1720, 0x1736, "3.2"},
+	{0x1740, 0x1753, "3.2"},
+	{0x1760, 0x176C, "3.2"},
+	{0x176E, 0x1770, "3.2"},
+	{0x1772, 0x1773, "3.2"},
+	{0x1780, 0x17DC, "3.0"},
+	{0x17DD, 0x17DD, "4.0"},
+	{0x17E0, 0x17E9, "3.0"},
+	{0x17F0, 0x17F9, "4.0"},
+	{0x1800, 0x180E, "3.0"},
+	{0x180F, 0x180F, "14.0"},
+	{0x1810, 0x1819, "3.0"},
+	{0x1820, 0x1877, "3.0"},
+	{0x1878, 0x1878, "11.0"},
+	{0x1880, 0x18A9, "3.0"},
+	{0x18AA, 0x18AA, "5.1"},
+	{0x18B0, 0x18F5, "5.2"},
+	{0x1900, 0x191C, "4.0"},
+	{0x191D, 0x191E, "7.0"},
+	{0x1920, 0x192B, "4.0"},
+	{0x1930, 0x193B, "4.0"},
+	{0x1940, 0x1940, "4.0"},
+	{0x1944, 0x196D, "4.0"},
+	{0x1970, 0x1974, "4.0"},
+	{0x1980, 0x19A9, "4.1"},
+	{0x19AA, 0x19AB, "5.2"},
+	{0x19B0, 0x19C9, "4.1"},
+	{0x19D0, 0x19D9, "4.1"},
+	{0x19DA, 0x19DA, "5.2"},
+	{0x19DE, 0x19DF, "4.1"},
+	{0x19E0, 0x19FF, "4.0"},
+	{0x1A00, 0x1A1B, "4.1"},
+	{0x1A1E, 0x1A1F, "4.1"},
+	{0x1A20, 0x1A5E, "5.2"},
+	{0x1A60, 0x1A7C, "5.2"},
+	{0x1A7F, 0x1A89, "5.2"},
+	{0x1A90, 0x1A99, "5.2"},
+	{0x1AA0, 0x1AAD, "5.2"},
+	{0x1AB0, 0x1ABE, "7.0"},
+	{0x1ABF, 0x1AC0, "13.0"},
+	{0x1AC1, 0x1ACE, "14.0"},
+	{0x1B00, 0x1B4B, "5.0"},
+	{0x1B4C, 0x1B4C, "14.0"},
+	{0x1B50, 0x1B7C, "5.0"},
+	{0x1B7D, 0x1B7E, "14.0"},
+	{0x1B80, 0x1BAA, "5.1"},
+	{0x1BAB, 0x1BAD, "6.1"},
+	{0x1BAE, 0x1BB9, "5.1"},
+	{0x1BBA, 0x1BBF, "6.1"},
+	{0x1BC0, 0x1BF3, "6.0"},
+	{0x1BFC, 0x1BFF, "6.0"},
+	{0x1C00, 0x1C37, "5.1"},
+	{0x1C3B, 0x1C49, "5.1"},
+	{0x1C4D, 0x1C7F, "5.1"},
+	{0x1C80, 0x1C88, "9.0"},
+	{0x1C90, 0x1CBA, "11.0"},
+	{0x1CBD, 0x1CBF, "11.0"},
+	{0x1CC0, 0x1CC7, "6.1"},
+	{0x1CD0, 0x1CF2, "5.2"},
+	{0x1CF3, 0x1CF6, "6.1"},
+	{0x1CF7, 0x1CF7, "10.0"},
+	{0x1CF8, 0x1CF9, "7.0"},
+	{0x1CFA, 0x1CFA, "12.0"},
+	{0x1D00, 0x1D6B, "4.0"},
+	{0x1D6C, 0x1DC3, "4.1"},
+	{0x1DC4, 0x1DCA, "5.0"},
+	{0x1DCB, 0x1DE6, "5.1"},
+	{0x1DE7, 0x1DF5, "7.0"},
+	{0x1DF6, 0x1DF9, "10.0"},
+	{0x1DFA, 0x1DFA, "14.0"},
+	{0x1DFB, 0x1DFB, "9.0"},
+	{0x1DFC, 0x1DFC, "6.0"},
+	{0x1DFD, 0x1DFD, "5.2"},
+	{0x1DFE, 0x1DFF, "5.0"},
+	{0x1E00, 0x1E9A, "1.1"},
+	{0x1E9B, 0x1E9B, "2.0"},
+	{0x1E9C, 0x1E9F, "5.1"},
+	{0x1EA0, 0x1EF9, "1.1"},
+	{0x1EFA, 0x1EFF, "5.1"},
+	{0x1F00, 0x1F15, "1.1"},
+	{0x1F18, 0x1F1D, "1.1"},
+	{0x1F20, 0x1F45, "1.1"},
+	{0x1F48, 0x1F4D, "1.1"},
+	{0x1F50, 0x1F57, "1.1"},
+	{0x1F59, 0x1F59, "1.1"},
+	{0x1F5B, 0x1F5B, "1.1"},
+	{0x1F5D, 0x1F5D, "1.1"},
+	{0x1F5F, 0x1F7D, "1.1"},
+	{0x1F80, 0x1FB4, "1.1"},
+	{0x1FB6, 0x1FC4, "1.1"},
+	{0x1FC6, 0x1FD3, "1.1"},
+	{0x1FD6, 0x1FDB, "1.1"},
+	{0x1FDD, 0x1FEF, "1.1"},
+	{0x1FF2, 0x1FF4, "1.1"},
+	{0x1FF6, 0x1FFE, "1.1"},
+	{0x2000, 0x202E, "1.1"},
+	{0x202F, 0x202F, "3.0"},
+	{0x2030, 0x2046, "1.1"},
+	{0x2047, 0x2047, "3.2"},
+	{0x2048, 0x204D, "3.0"},
+	{0x204E, 0x2052, "3.2"},
+	{0x2053, 0x2054, "4.0"},
+	{0x2055, 0x2056, "4.1"},
+	{0x2057, 0x2057, "3.2"},
+	{0x2058, 0x205E, "4.1"},
+	{0x205F, 0x2063, "3.2"},
+	{0x2064, 0x2064, "5.1"},
+	{0x2066, 0x2069, "6.3"},
+	{0x206A, 0x2070, "1.1"},
+	{0x2071, 0x2071, "3.2"},
+	{0x2074, 0x208E, "1.1"},
+	{0x2090, 0x2094, "4.1"},
+	{0x2095, 0x209C, "6.0"},
+	{0x20A0, 0x20AA, "1.1"},
+	{0x20AB, 0x20AB, "2.0"},
+	{0x20AC, 0x20AC, "2.1"},
+	{0x20AD, 0x20AF, "3.0"},
+	{0x20B0, 0x20B1, "3.2"},
+	{0x20B2, 0x20B5, "4.1"},
+	{0x20B6, 0x20B8, "5.2"},
+	{0x20B9, 0x20B9, "6.0"},
+	{0x20BA, 0x20BA, "6.2"},
+	{0x20BB, 0x20BD, "7.0"},
+	{0x20BE, 0x20BE, "8.0"},
+	{0x20BF, 0x20BF, "10.0"},
+	{0x20C0, 0x20C0, "14.0"},
+	{0x20D0, 0x20E1, "1.1"},
+	{0x20E2, 0x20E3, "3.0"},
+	{0x20E4, 0x20EA, "3.2"},
+	{0x20EB, 0x20EB, "4.1"},
+	{0x20EC, 0x20EF, "5.0"},
+	{0x20F0, 0x20F0, "5.1"},
+	{0x2100, 0x2138, "1.1"},
+	{0x2139, 0x213A, "3.0"},
+	{0x213B, 0x213B, "4.0"},
+	{0x213C, 0x213C, "4.1"},
+	{0x213D, 0x214B, "3.2"},
+	{0x214C, 0x214C, "4.1"},
+	{0x214D, 0x214E, "5.0"},
+	{0x214F, 0x214F, "5.1"},
+	{0x2150, 0x2152, "5.2"},
+	{0x2153, 0x2182, "1.1"},
+	{0x2183, 0x2183, "3.0"},
+	{0x2184, 0x2184, "5.0"},
+	{0x2185, 0x2188, "5.1"},
+	{0x2189, 0x2189, "5.2"},
+	{0x218A, 0x218B, "8.0"},
+	{0x2190, 0x21EA, "1.1"},
+	{0x21EB, 0x21F3, "3.0"},
+	{0x21F4, 0x21FF, "3.2"},
+	{0x2200, 0x22F1, "1.1"},
+	{0x22F2, 0x22FF, "3.2"},
+	{0x2300, 0x2300, "1.1"},
+	{0x2301, 0x2301, "3.0"},
+	{0x2302, 0x237A, "1.1"},
+	{0x237B, 0x237B, "3.0"},
+	{0x237C, 0x237C, "3.2"},
+	{0x237D, 0x239A, "3.0"},
+	{0x239B, 0x23CE, "3.2"},
+	{0x23CF, 0x23D0, "4.0"},
+	{0x23D1, 0x23DB, "4.1"},
+	{0x23DC, 0x23E7, "5.0"},
+	{0x23E8, 0x23E8, "5.2"},
+	{0x23E9, 0x23F3, "6.0"},
+	{0x23F4, 0x23FA, "7.0"},
+	{0x23FB, 0x23FE, "9.0"},
+	{0x23FF, 0x23FF, "10.0"},
+	{0x2400, 0x2424, "1.1"},
+	{0x2425, 0x2426, "3.0"},
+	{0x2440, 0x244A, "1.1"},
+	{0x2460, 0x24EA, "1.1"},
+	{0x24EB, 0x24FE, "3.2"},
+	{0x24FF, 0x24FF, "4.0"},
+	{0x2500, 0x2595, "1.1"},
+	{0x2596, 0x259F, "3.2"},
+	{0x25A0, 0x25EF, "1.1"},
+	{0x25F0, 0x25F7, "3.0"},
+	{0x25F8, 0x25FF, "3.2"},
+	{0x2600, 0x2613, "1.1"},
+	{0x2614, 0x2615, "4.0"},
+	{0x2616, 0x2617, "3.2"},
+	{0x2618, 0x2618, "4.1"},
+	{0x2619, 0x2619, "3.0"},
+	{0x261A, 0x266F, "1.1"},
+	{0x2670, 0x2671, "3.0"},
+	{0x2672, 0x267D, "3.2"},
+	{0x267E, 0x267F, "4.1"},
+	{0x2680, 0x2689, "3.2"},
+	{0x268A, 0x2691, "4.0"},
+	{0x2692, 0x269C, "4.1"},
+	{0x269D, 0x269D, "5.1"},
+	{0x269E, 0x269F, "5.2"},
+	{0x26A0, 0x26A1, "4.0"},
+	{0x26A2, 0x26B1, "4.1"},
+	{0x26B2, 0x26B2, "5.0"},
+	{0x26B3, 0x26BC, "5.1"},
+	{0x26BD, 0x26BF, "5.2"},
+	{0x26C0, 0x26C3, "5.1"},
+	{0x26C4, 0x26CD, "5.2"},
+	{0x26CE, 0x26CE, "6.0"},
+	{0x26CF, 0x26E1, "5.2"},
+	{0x26E2, 0x26E2, "6.0"},
+	{0x26E3, 0x26E3, "5.2"},
+	{0x26E4, 0x26E7, "6.0"},
+	{0x26E8, 0x26FF, "5.2"},
+	{0x2700, 0x2700, "7.0"},
+	{0x2701, 0x2704, "1.1"},
+	{0x2705, 0x2705, "6.0"},
+	{0x2706, 0x2709, "1.1"},
+	{0x270A, 0x270B, "6.0"},
+	{0x270C, 0x2727, "1.1"},
+	{0x2728, 0x2728, "6.0"},
+	{0x2729, 0x274B, "1.1"},
+	{0x274C, 0x274C, "6.0"},
+	{0x274D, 0x274D, "1.1"},
+	{0x274E, 0x274E, "6.0"},
+	{0x274F, 0x2752, "1.1"},
+	{0x2753, 0x2755, "6.0"},
+	{0x2756, 0x2756, "1.1"},
+	{0x2757, 0x2757, "5.2"},
+	{0x2758, 0x275E, "1.1"},
+	{0x275F, 0x2760, "6.0"},
+	{0x2761, 0x2767, "1.1"},
+	{0x2768, 0x2775, "3.2"},
+	{0x2776, 0x2794, "1.1"},
+	{0x2795, 0x2797, "6.0"},
+	{0x2798, 0x27AF, "1.1"},
+	{0x27B0, 0x27B0, "6.0"},
+	{0x27B1, 0x27BE, "1.1"},
+	{0x27BF, 0x27BF, "6.0"},
+	{0x27C0, 0x27C6, "4.1"},
+	{0x27C7, 0x27CA, "5.0"},
+	{0x27CB, 0x27CB, "6.1"},
+	{0x27CC, 0x27CC, "5.1"},
+	{0x27CD, 0x27CD, "6.1"},
+	{0x27CE, 0x27CF, "6.0"},
+	{0x27D0, 0x27EB, "3.2"},
+	{0x27EC, 0x27EF, "5.1"},
+	{0x27F0, 0x27FF, "3.2"},
+	{0x2800, 0x28FF, "3.0"},
+	{0x2900, 0x2AFF, "3.2"},
+	{0x2B00, 0x2B0D, "4.0"},
+	{0x2B0E, 0x2B13, "4.1"},
+	{0x2B14, 0x2B1A, "5.0"},
+	{0x2B1B, 0x2B1F, "5.1"},
+	{0x2B20, 0x2B23, "5.0"},
+	{0x2B24, 0x2B4C, "5.1"},
+	{0x2B4D, 0x2B4F, "7.0"},
+	{0x2B50, 0x2B54, "5.1"},
+	{0x2B55, 0x2B59, "5.2"},
+	{0x2B5A, 0x2B73, "7.0"},
+	{0x2B76, 0x2B95, "7.0"},
+	{0x2B97, 0x2B97, "13.0"},
+	{0x2B98, 0x2BB9, "7.0"},
+	{0x2BBA, 0x2BBC, "11.0"},
+	{0x2BBD, 0x2BC8, "7.0"},
+	{0x2BC9, 0x2BC9, "12.0"},
+	{0x2BCA, 0x2BD1, "7.0"},
+	{0x2BD2, 0x2BD2, "10.0"},
+	{0x2BD3, 0x2BEB, "11.0"},
+	{0x2BEC, 0x2BEF, "8.0"},
+	{0x2BF0, 0x2BFE, "11.0"},
+	{0x2BFF, 0x2BFF, "12.0"},
+	{0x2C00, 0x2C2E, "4.1"},
+	{0x2C2F, 0x2C2F, "14.0"},
+	{0x2C30, 0x2C5E, "4.1"},
+	{0x2C5F, 0x2C5F, "14.0"},
+	{0x2C60, 0x2C6C, "5.0"},
+	{0x2C6D, 0x2C6F, "5.1"},
+	{0x2C70, 0x2C70, "5.2"},
+	{0x2C71, 0x2C73, "5.1"},
+	{0x2C74, 0x2C77, "5.0"},
+	{0x2C78, 0x2C7D, "5.1"},
+	{0x2C7E, 0x2C7F, "5.2"},
+	{0x2C80, 0x2CEA, "4.1"},
+	{0x2CEB, 0x2CF1, "5.2"},
+	{0x2CF2, 0x2CF3, "6.1"},
+	{0x2CF9, 0x2D25, "4.1"},
+	{0x2D27, 0x2D27, "6.1"},
+	{0x2D2D, 0x2D2D, "6.1"},
+	{0x2D30, 0x2D65, "4.1"},
+	{0x2D66, 0x2D67, "6.1"},
+	{0x2D6F, 0x2D6F, "4.1"},
+	{0x2D70, 0x2D70, "6.0"},
+	{0x2D7F, 0x2D7F, "6.0"},
+	{0x2D80, 0x2D96, "4.1"},
+	{0x2DA0, 0x2DA6, "4.1"},
+	{0x2DA8, 0x2DAE, "4.1"},
+	{0x2DB0, 0x2DB6, "4.1"},
+	{0x2DB8, 0x2DBE, "4.1"},
+	{0x2DC0, 0x2DC6, "4.1"},
+	{0x2DC8, 0x2DCE, "4.1"},
+	{0x2DD0, 0x2DD6, "4.1"},
+	{0x2DD8, 0x2DDE, "4.1"},
+	{0x2DE0, 0x2DFF, "5.1"},
+	{0x2E00, 0x2E17, "4.1"},
+	{0x2E18, 0x2E1B, "5.1"},
+	{0x2E1C, 0x2E1D, "4.1"},
+	{0x2E1E, 0x2E30, "5.1"},
+	{0x2E31, 0x2E31, "5.2"},
+	{0x2E32, 0x2E3B, "6.1"},
+	{0x2E3C, 0x2E42, "7.0"},
+	{0x2E43, 0x2E44, "9.0"},
+	{0x2E45, 0x2E49, "10.0"},
+	{0x2E4A, 0x2E4E, "11.0"},
+	{0x2E4F, 0x2E4F, "12.0"},
+	{0x2E50, 0x2E52, "13.0"},
+	{0x2E53, 0x2E5D, "14.0"},
+	{0x2E80, 0x2E99, "3.0"},
+	{0x2E9B, 0x2EF3, "3.0"},
+	{0x2F00, 0x2FD5, "3.0"},
+	{0x2FF0, 0x2FFB, "3.0"},
+	{0x3000, 0x3037, "1.1"},
+	{0x3038, 0x303A, "3.0"},
+	{0x303B, 0x303D, "3.2"},
+	{0x303E, 0x303E, "3.0"},
+	{0x303F, 0x303F, "1.1"},
+	{0x3041, 0x3094, "1.1"},
+	{0x3095, 0x3096, "3.2"},
+	{0x3099, 0x309E, "1.1"},
+	{0x309F, 0x30A0, "3.2"},
+	{0x30A1, 0x30FE, "1.1"},
+	{0x30FF, 0x30FF, "3.2"},
+	{0x3105, 0x312C, "1.1"},
+	{0x312D, 0x312D, "5.1"},
+	{0x312E, 0x312E, "10.0"},
+	{0x312F, 0x312F, "11.0"},
+	{0x3131, 0x318E, "1.1"},
+	{0x3190, 0x319F, "1.1"},
+	{0x31A0, 0x31B7, "3.0"},
+	{0x31B8, 0x31BA, "6.0"},
+	{0x31BB, 0x31BF, "13.0"},
+	{0x31C0, 0x31CF, "4.1"},
+	{0x31D0, 0x31E3, "5.1"},
+	{0x31F0, 0x31FF, "3.2"},
+	{0x3200, 0x321C, "1.1"},
+	{0x321D, 0x321E, "4.0"},
+	{0x3220, 0x3243, "1.1"},
+	{0x3244, 0x324F, "5.2"},
+	{0x3250, 0x3250, "4.0"},
+	{0x3251, 0x325F, "3.2"},
+	{0x3260, 0x327B, "1.1"},
+	{0x327C, 0x327D, "4.0"},
+	{0x327E, 0x327E, "4.1"},
+	{0x327F, 0x32B0, "1.1"},
+	{0x32B1, 0x32BF, "3.2"},
+	{0x32C0, 0x32CB, "1.1"},
+	{0x32CC, 0x32CF, "4.0"},
+	{0x32D0, 0x32FE, "1.1"},
+	{0x32FF, 0x32FF, "12.1"},
+	{0x3300, 0x3376, "1.1"},
+	{0x3377, 0x337A, "4.0"},
+	{0x337B, 0x33DD, "1.1"},
+	{0x33DE, 0x33DF, "4.0"},
+	{0x33E0, 0x33FE, "1.1"},
+	{0x33FF, 0x33FF, "4.0"},
+	{0x3400, 0x4DB5, "3.0"},
+	{0x4DB6, 0x4DBF, "13.0"},
+	{0x4DC0, 0x4DFF, "4.0"},
+	{0x4E00, 0x9FA5, "1.1"},
+	{0x9FA6, 0x9FBB, "4.1"},
+	{0x9FBC, 0x9FC3, "5.1"},
+	{0x9FC4, 0x9FCB, "5.2"},
+	{0x9FCC, 0x9FCC, "6.1"},
+	{0x9FCD, 0x9FD5, "8.0"},
+	{0x9FD6, 0x9FEA, "10.0"},
+	{0x9FEB, 0x9FEF, "11.0"},
+	{0x9FF0, 0x9FFC, "13.0"},
+	{0x9FFD, 0x9FFF, "14.0"},
+	{0xA000, 0xA48C, "3.0"},
+	{0xA490, 0xA4A1, "3.0"},
+	{0xA4A2, 0xA4A3, "3.2"},
+	{0xA4A4, 0xA4B3, "3.0"},
+	{0xA4B4, 0xA4B4, "3.2"},
+	{0xA4B5, 0xA4C0, "3.0"},
+	{0xA4C1, 0xA4C1, "3.2"},
+	{0xA4C2, 0xA4C4, "3.0"},
+	{0xA4C5, 0xA4C5, "3.2"},
+	{0xA4C6, 0xA4C6, "3.0"},
+	{0xA4D0, 0xA4FF, "5.2"},
+	{0xA500, 0xA62B, "5.1"},
+	{0xA640, 0xA65F, "5.1"},
+	{0xA660, 0xA661, "6.0"},
+	{0xA662, 0xA673, "5.1"},
+	{0xA674, 0xA67B, "6.1"},
+	{0xA67C, 0xA697, "5.1"},
+	{0xA698, 0xA69D, "7.0"},
+	{0xA69E, 0xA69E, "8.0"},
+	{0xA69F, 0xA69F, "6.1"},
+	{0xA6A0, 0xA6F7, "5.2"},
+	{0xA700, 0xA716, "4.1"},
+	{0xA717, 0xA71A, "5.0"},
+	{0xA71B, 0xA71F, "5.1"},
+	{0xA720, 0xA721, "5.0"},
+	{0xA722, 0xA78C, "5.1"},
+	{0xA78D, 0xA78E, "6.0"},
+	{0xA78F, 0xA78F, "8.0"},
+	{0xA790, 0xA791, "6.0"},
+	{0xA792, 0xA793, "6.1"},
+	{0xA794, 0xA79F, "7.0"},
+	{0xA7A0, 0xA7A9, "6.0"},
+	{0xA7AA, 0xA7AA, "6.1"},
+	{0xA7AB, 0xA7AD, "7.0"},
+	{0xA7AE, 0xA7AE, "9.0"},
+	{0xA7AF, 0xA7AF, "11.0"},
+	{0xA7B0, 0xA7B1, "7.0"},
+	{0xA7B2, 0xA7B7, "8.0"},
+	{0xA7B8, 0xA7B9, "11.0"},
+	{0xA7BA, 0xA7BF, "12.0"},
+	{0xA7C0, 0xA7C1, "14.0"},
+	{0xA7C2, 0xA7C6, "12.0"},
+	{0xA7C7, 0xA7CA, "13.0"},
+	{0xA7D0, 0xA7D1, "14.0"},
+	{0xA7D3, 0xA7D3, "14.0"},
+	{0xA7D5, 0xA7D9, "14.0"},
+	{0xA7F2, 0xA7F4, "14.0"},
+	{0xA7F5, 0xA7F6, "13.0"},
+	{0xA7F7, 0xA7F7, "7.0"},
+	{0xA7F8, 0xA7F9, "6.1"},
+	{0xA7FA, 0xA7FA, "6.0"},
+	{0xA7FB, 0xA7FF, "5.1"},
+	{0xA800, 0xA82B, "4.1"},
+	{0xA82C, 0xA82C, "13.0"},
+	{0xA830, 0xA839, "5.2"},
+	{0xA840, 0xA877, "5.0"},
+	{0xA880, 0xA8C4, "5.1"},
+	{0xA8C5, 0xA8C5, "9.0"},
+	{0xA8CE, 0xA8D9, "5.1"},
+	{0xA8E0, 0xA8FB, "5.2"},
+	{0xA8FC, 0xA8FD, "8.0"},
+	{0xA8FE, 0xA8FF, "11.0"},
+	{0xA900, 0xA953, "5.1"},
+	{0xA95F, 0xA95F, "5.1"},
+	{0xA960, 0xA97C, "5.2"},
+	{0xA980, 0xA9CD, "5.2"},
+	{0xA9CF, 0xA9D9, "5.2"},
+	{0xA9DE, 0xA9DF, "5.2"},
+	{0xA9E0, 0xA9FE, "7.0"},
+	{0xAA00, 0xAA36, "5.1"},
+	{0xAA40, 0xAA4D, "5.1"},
+	{0xAA50, 0xAA59, "5.1"},
+	{0xAA5C, 0xAA5F, "5.1"},
+	{0xAA60, 0xAA7B, "5.2"},
+	{0xAA7C, 0xAA7F, "7.0"},
+	{0xAA80, 0xAAC2, "5.2"},
+	{0xAADB, 0xAADF, "5.2"},
+	{0xAAE0, 0xAAF6, "6.1"},
+	{0xAB01, 0xAB06, "6.0"},
+	{0xAB09, 0xAB0E, "6.0"},
+	{0xAB11, 0xAB16, "6.0"},
+	{0xAB20, 0xAB26, "6.0"},
+	{0xAB28, 0xAB2E, "6.0"},
+	{0xAB30, 0xAB5F, "7.0"},
+	{0xAB60, 0xAB63, "8.0"},
+	{0xAB64, 0xAB65, "7.0"},
+	{0xAB66, 0xAB67, "12.0"},
+	{0xAB68, 0xAB6B, "13.0"},
+	{0xAB70, 0xABBF, "8.0"},
+	{0xABC0, 0xABED, "5.2"},
+	{0xABF0, 0xABF9, "5.2"},
+	{0xAC00, 0xD7A3, "2.0"},
+	{0xD7B0, 0xD7C6, "5.2"},
+	{0xD7CB, 0xD7FB, "5.2"},
+	{0xD800, 0xDFFF, "2.0"},
+	{0xE000, 0xFA2D, "1.1"},
+	{0xFA2E, 0xFA2F, "6.1"},
+	{0xFA30, 0xFA6A, "3.2"},
+	{0xFA6B, 0xFA6D, "5.2"},
+	{0xFA70, 0xFAD9, "4.1"},
+	{0xFB00, 0xFB06, "1.1"},
+	{0xFB13, 0xFB17, "1.1"},
+	{0xFB1D, 0xFB1D, "3.0"},
+	{0xFB1E, 0xFB36, "1.1"},
+	{0xFB38, 0xFB3C, "1.1"},
+	{0xFB3E, 0xFB3E, "1.1"},
+	{0xFB40, 0xFB41, "1.1"},
+	{0xFB43, 0xFB44, "1.1"},
+	{0xFB46, 0xFBB1, "1.1"},
+	{0xFBB2, 0xFBC1, "6.0"},
+	{0xFBC2, 0xFBC2, "14.0"},
+	{0xFBD3, 0xFD3F, "1.1"},
+	{0xFD40, 0xFD4F, "14.0"},
+	{0xFD50, 0xFD8F, "1.1"},
+	{0xFD92, 0xFDC7, "1.1"},
+	{0xFDCF, 0xFDCF, "14.0"},
+	{0xFDD0, 0xFDEF, "3.1"},
+	{0xFDF0, 0xFDFB, "1.1"},
+	{0xFDFC, 0xFDFC, "3.2"},
+	{0xFDFD, 0xFDFD, "4.0"},
+	{0xFDFE, 0xFDFF, "14.0"},
+	{0xFE00, 0xFE0F, "3.2"},
+	{0xFE10, 0xFE19, "4.1"},
+	{0xFE20, 0xFE23, "1.1"},
+	{0xFE24, 0xFE26, "5.1"},
+	{0xFE27, 0xFE2D, "7.0"},
+	{0xFE2E, 0xFE2F, "8.0"},
+	{0xFE30, 0xFE44, "1.1"},
+	{0xFE45, 0xFE46, "3.2"},
+	{0xFE47, 0xFE48, "4.0"},
+	{0xFE49, 0xFE52, "1.1"},
+	{0xFE54, 0xFE66, "1.1"},
+	{0xFE68, 0xFE6B, "1.1"},
+	{0xFE70, 0xFE72, "1.1"},
+	{0xFE73, 0xFE73, "3.2"},
+	{0xFE74, 0xFE74, "1.1"},
+	{0xFE76, 0xFEFC, "1.1"},
+	{0xFEFF, 0xFEFF, "1.1"},
+	{0xFF01, 0xFF5E, "1.1"},
+	{0xFF5F, 0xFF60, "3.2"},
+	{0xFF61, 0xFFBE, "1.1"},
+	{0xFFC2, 0xFFC7, "1.1"},
+	{0xFFCA, 0xFFCF, "1.1"},
+	{0xFFD2, 0xFFD7, "1.1"},
+	{0xFFDA, 0xFFDC, "1.1"},
+	{0xFFE0, 0xFFE6, "1.1"},
+	{0xFFE8, 0xFFEE, "1.1"},
+	{0xFFF9, 0xFFFB, "3.0"},
+	{0xFFFC, 0xFFFC, "2.1"},
+	{0xFFFD, 0xFFFF, "1.1"},
+	{0x10000, 0x1000B, "4.0"},
+	{0x1000D, 0x10026, "4.0"},
+	{0x10028, 0x1003A, "4.0"},
+	{0x1003C, 0x1003D, "4.0"},
+	{0x1003F, 0x1004D, "4.0"},
+	{0x10050, 0x1005D, "4.0"},
+	{0x10080, 0x100FA, "4.0"},
+	{0x10100, 0x10102, "4.0"},
+	{0x10107, 0x10133, "4.0"},
+	{0x10137, 0x1013F, "4.0"},
+	{0x10140, 0x1018A, "4.1"},
+	{0x1018B, 0x1018C, "7.0"},
+	{0x1018D, 0x1018E, "9.0"},
+	{0x10190, 0x1019B, "5.1"},
+	{0x1019C, 0x1019C, "13.0"},
+	{0x101A0, 0x101A0, "7.0"},
+	{0x101D0, 0x101FD, "5.1"},
+	{0x10280, 0x1029C, "5.1"},
+	{0x102A0, 0x102D0, "5.1"},
+	{0x102E0, 0x102FB, "7.0"},
+	{0x10300, 0x1031E, "3.1"},
+	{0x1031F, 0x1031F, "7.0"},
+	{0x10320, 0x10323, "3.1"},
+	{0x1032D, 0x1032F, "10.0"},
+	{0x10330, 0x1034A, "3.1"},
+	{0x10350, 0x1037A, "7.0"},
+	{0x10380, 0x1039D, "4.0"},
+	{0x1039F, 0x1039F, "4.0"},
+	{0x103A0, 0x103C3, "4.1"},
+	{0x103C8, 0x103D5, "4.1"},
+	{0x10400, 0x10425, "3.1"},
+	{0x10426, 0x10427, "4.0"},
+	{0x10428, 0x1044D, "3.1"},
+	{0x1044E, 0x1049D, "4.0"},
+	{0x104A0, 0x104A9, "4.0"},
+	{0x104B0, 0x104D3, "9.0"},
+	{0x104D8, 0x104FB, "9.0"},
+	{0x10500, 0x10527, "7.0"},
+	{0x10530, 0x10563, "7.0"},
+	{0x1056F, 0x1056F, "7.0"},
+	{0x10570, 0x1057A, "14.0"},
+	{0x1057C, 0x1058A, "14.0"},
+	{0x1058C, 0x10592, "14.0"},
+	{0x10594, 0x10595, "14.0"},
+	{0x10597, 0x105A1, "14.0"},
+	{0x105A3, 0x105B1, "14.0"},
+	{0x105B3, 0x105B9, "14.0"},
+	{0x105BB, 0x105BC, "14.0"},
+	{0x10600, 0x10736, "7.0"},
+	{0x10740, 0x10755, "7.0"},
+	{0x10760, 0x10767, "7.0"},
+	{0x10780, 0x10785, "14.0"},
+	{0x10787, 0x107B0, "14.0"},
+	{0x107B2, 0x107BA, "14.0"},
+	{0x10800, 0x10805, "4.0"},
+	{0x10808, 0x10808, "4.0"},
+	{0x1080A, 0x10835, "4.0"},
+	{0x10837, 0x10838, "4.0"},
+	{0x1083C, 0x1083C, "4.0"},
+	{0x1083F, 0x1083F, "4.0"},
+	{0x10840, 0x10855, "5.2"},
+	{0x10857, 0x1085F, "5.2"},
+	{0x10860, 0x1089E, "7.0"},
+	{0x108A7, 0x108AF, "7.0"},
+	{0x108E0, 0x108F2, "8.0"},
+	{0x108F4, 0x108F5, "8.0"},
+	{0x108FB, 0x108FF, "8.0"},
+	{0x10900, 0x10919, "5.0"},
+	{0x1091A, 0x1091B, "5.2"},
+	{0x1091F, 0x1091F, "5.0"},
+	{0x10920, 0x10939, "5.1"},
+	{0x1093F, 0x1093F, "5.1"},
+	{0x10980, 0x109B7, "6.1"},
+	{0x109BC, 0x109BD, "8.0"},
+	{0x109BE, 0x109BF, "6.1"},
+	{0x109C0, 0x109CF, "8.0"},
+	{0x109D2, 0x109FF, "8.0"},
+	{0x10A00, 0x10A03, "4.1"},
+	{0x10A05, 0x10A06, "4.1"},
+	{0x10A0C, 0x10A13, "4.1"},
+	{0x10A15, 0x10A17, "4.1"},
+	{0x10A19, 0x10A33, "4.1"},
+	{0x10A34, 0x10A35, "11.0"},
+	{0x10A38, 0x10A3A, "4.1"},
+	{0x10A3F, 0x10A47, "4.1"},
+	{0x10A48, 0x10A48, "11.0"},
+	{0x10A50, 0x10A58, "4.1"},
+	{0x10A60, 0x10A7F, "5.2"},
+	{0x10A80, 0x10A9F, "7.0"},
+	{0x10AC0, 0x10AE6, "7.0"},
+	{0x10AEB, 0x10AF6, "7.0"},
+	{0x10B00, 0x10B35, "5.2"},
+	{0x10B39, 0x10B55, "5.2"},
+	{0x10B58, 0x10B72, "5.2"},
+	{0x10B78, 0x10B7F, "5.2"},
+	{0x10B80, 0x10B91, "7.0"},
+	{0x10B99, 0x10B9C, "7.0"},
+	{0x10BA9, 0x10BAF, "7.0"},
+	{0x10C00, 0x10C48, "5.2"},
+	{0x10C80, 0x10CB2, "8.0"},
+	{0x10CC0, 0x10CF2, "8.0"},
+	{0x10CFA, 0x10CFF, "8.0"},
+	{0x10D00, 0x10D27, "11.0"},
+	{0x10D30, 0x10D39, "11.0"},
+	{0x10E60, 0x10E7E, "5.2"},
+	{0x10E80, 0x10EA9, "13.0"},
+	{0x10EAB, 0x10EAD, "13.0"},
+	{0x10EB0, 0x10EB1, "13.0"},
+	{0x10F00, 0x10F27, "11.0"},
+	{0x10F30, 0x10F59, "11.0"},
+	{0x10F70, 0x10F89, "14.0"},
+	{0x10FB0, 0x10FCB, "13.0"},
+	{0x10FE0, 0x10FF6, "12.0"},
+	{0x11000, 0x1104D, "6.0"},
+	{0x11052, 0x1106F, "6.0"},
+	{0x11070, 0x11075, "14.0"},
+	{0x1107F, 0x1107F, "7.0"},
+	{0x11080, 0x110C1, "5.2"},
+	{0x110C2, 0x110C2, "14.0"},
+	{0x110CD, 0x110CD, "11.0"},
+	{0x110D0, 0x110E8, "6.1"},
+	{0x110F0, 0x110F9, "6.1"},
+	{0x11100, 0x11134, "6.1"},
+	{0x11136, 0x11143, "6.1"},
+	{0x11144, 0x11146, "11.0"},
+	{0x11147, 0x11147, "13.0"},
+	{0x11150, 0x11176, "7.0"},
+	{0x11180, 0x111C8, "6.1"},
+	{0x111C9, 0x111CC, "8.0"},
+	{0x111CD, 0x111CD, "7.0"},
+	{0x111CE, 0x111CF, "13.0"},
+	{0x111D0, 0x111D9, "6.1"},
+	{0x111DA, 0x111DA, "7.0"},
+	{0x111DB, 0x111DF, "8.0"},
+	{0x111E1, 0x111F4, "7.0"},
+	{0x11200, 0x11211, "7.0"},
+	{0x11213, 0x1123D, "7.0"},
+	{0x1123E, 0x1123E, "9.0"},
+	{0x11280, 0x11286, "8.0"},
+	{0x11288, 0x11288, "8.0"},
+	{0x1128A, 0x1128D, "8.0"},
+	{0x1128F, 0x1129D, "8.0"},
+	{0x1129F, 0x112A9, "8.0"},
+	{0x112B0, 0x112EA, "7.0"},
+	{0x112F0, 0x112F9, "7.0"},
+	{0x11300, 0x11300, "8.0"},
+	{0x11301, 0x11303, "7.0"},
+	{0x11305, 0x1130C, "7.0"},
+	{0x1130F, 0x11310, "7.0"},
+	{0x11313, 0x11328, "7.0"},
+	{0x1132A, 0x11330, "7.0"},
+	{0x11332, 0x11333, "7.0"},
+	{0x11335, 0x11339, "7.0"},
+	{0x1133B, 0x1133B, "11.0"},
+	{0x1133C, 0x11344, "7.0"},
+	{0x11347, 0x11348, "7.0"},
+	{0x1134B, 0x1134D, "7.0"},
+	{0x11350, 0x11350, "8.0"},
+	{0x11357, 0x11357, "7.0"},
+	{0x1135D, 0x11363, "7.0"},
+	{0x11366, 0x1136C, "7.0"},
+	{0x11370, 0x11374, "7.0"},
+	{0x11400, 0x11459, "9.0"},
+	{0x1145A, 0x1145A, "13.0"},
+	{0x1145B, 0x1145B, "9.0"},
+	{0x1145D, 0x1145D, "9.0"},
+	{0x1145E, 0x1145E, "11.0"},
+	{0x1145F, 0x1145F, "12.0"},
+	{0x11460, 0x11461, "13.0"},
+	{0x11480, 0x114C7, "7.0"},
+	{0x114D0, 0x114D9, "7.0"},
+	{0x11580, 0x115B5, "7.0"},
+	{0x115B8, 0x115C9, "7.0"},
+	{0x115CA, 0x115DD, "8.0"},
+	{0x11600, 0x11644, "7.0"},
+	{0x11650, 0x11659, "7.0"},
+	{0x11660, 0x1166C, "9.0"},
+	{0x11680, 0x116B7, "6.1"},
+	{0x116B8, 0x116B8, "12.0"},
+	{0x116B9, 0x116B9, "14.0"},
+	{0x116C0, 0x116C9, "6.1"},
+	{0x11700, 0x11719, "8.0"},
+	{0x1171A, 0x1171A, "11.0"},
+	{0x1171D, 0x1172B, "8.0"},
+	{0x11730, 0x1173F, "8.0"},
+	{0x11740, 0x11746, "14.0"},
+	{0x11800, 0x1183B, "11.0"},
+	{0x118A0, 0x118F2, "7.0"},
+	{0x118FF, 0x118FF, "7.0"},
+	{0x11900, 0x11906, "13.0"},
+	{0x11909, 0x11909, "13.0"},
+	{0x1190C, 0x11913, "13.0"},
+	{0x11915, 0x11916, "13.0"},
+	{0x11918, 0x11935, "13.0"},
+	{0x11937, 0x11938, "13.0"},
+	{0x1193B, 0x11946, "13.0"},
+	{0x11950, 0x11959, "13.0"},
+	{0x119A0, 0x119A7, "12.0"},
+	{0x119AA, 0x119D7, "12.0"},
+	{0x119DA, 0x119E4, "12.0"},
+	{0x11A00, 0x11A47, "10.0"},
+	{0x11A50, 0x11A83, "10.0"},
+	{0x11A84, 0x11A85, "12.0"},
+	{0x11A86, 0x11A9C, "10.0"},
+	{0x11A9D, 0x11A9D, "11.0"},
+	{0x11A9E, 0x11AA2, "10.0"},
+	{0x11AB0, 0x11ABF, "14.0"},
+	{0x11AC0, 0x11AF8, "7.0"},
+	{0x11C00, 0x11C08, "9.0"},
+	{0x11C0A, 0x11C36, "9.0"},
+	{0x11C38, 0x11C45, "9.0"},
+	{0x11C50, 0x11C6C, "9.0"},
+	{0x11C70, 0x11C8F, "9.0"},
+	{0x11C92, 0x11CA7, "9.0"},
+	{0x11CA9, 0x11CB6, "9.0"},
+	{0x11D00, 0x11D06, "10.0"},
+	{0x11D08, 0x11D09, "10.0"},
+	{0x11D0B, 0x11D36, "10.0"},
+	{0x11D3A, 0x11D3A, "10.0"},
+	{0x11D3C, 0x11D3D, "10.0"},
+	{0x11D3F, 0x11D47, "10.0"},
+	{0x11D50, 0x11D59, "10.0"},
+	{0x11D60, 0x11D65, "11.0"},
+	{0x11D67, 0x11D68, "11.0"},
+	{0x11D6A, 0x11D8E, "11.0"},
+	{0x11D90, 0x11D91, "11.0"},
+	{0x11D93, 0x11D98, "11.0"},
+	{0x11DA0, 0x11DA9, "11.0"},
+	{0x11EE0, 0x11EF8, "11.0"},
+	{0x11FB0, 0x11FB0, "13.0"},
+	{0x11FC0, 0x11FF1, "12.0"},
+	{0x11FFF, 0x11FFF, "12.0"},
+	{0x12000, 0x1236E, "5.0"},
+	{0x1236F, 0x12398, "7.0"},
+	{0x12399, 0x12399, "8.0"},
+	{0x12400, 0x12462, "5.0"},
+	{0x12463, 0x1246E, "7.0"},
+	{0x12470, 0x12473, "5.0"},
+	{0x12474, 0x12474, "7.0"},
+	{0x12480, 0x12543, "8.0"},
+	{0x12F90, 0x12FF2, "14.0"},
+	{0x13000, 0x1342E, "5.2"},
+	{0x13430, 0x13438, "12.0"},
+	{0x14400, 0x14646, "8.0"},
+	{0x16800, 0x16A38, "6.0"},
+	{0x16A40, 0x16A5E, "7.0"},
+	{0x16A60, 0x16A69, "7.0"},
+	{0x16A6E, 0x16A6F, "7.0"},
+	{0x16A70, 0x16ABE, "14.0"},
+	{0x16AC0, 0x16AC9, "14.0"},
+	{0x16AD0, 0x16AED, "7.0"},
+	{0x16AF0, 0x16AF5, "7.0"},
+	{0x16B00, 0x16B45, "7.0"},
+	{0x16B50, 0x16B59, "7.0"},
+	{0x16B5B, 0x16B61, "7.0"},
+	{0x16B63, 0x16B77, "7.0"},
+	{0x16B7D, 0x16B8F, "7.0"},
+	{0x16E40, 0x16E9A, "11.0"},
+	{0x16F00, 0x16F44, "6.1"},
+	{0x16F45, 0x16F4A, "12.0"},
+	{0x16F4F, 0x16F4F, "12.0"},
+	{0x16F50, 0x16F7E, "6.1"},
+	{0x16F7F, 0x16F87, "12.0"},
+	{0x16F8F, 0x16F9F, "6.1"},
+	{0x16FE0, 0x16FE0, "9.0"},
+	{0x16FE1, 0x16FE1, "10.0"},
+	{0x16FE2, 0x16FE3, "12.0"},
+	{0x16FE4, 0x16FE4, "13.0"},
+	{0x16FF0, 0x16FF1, "13.0"},
+	{0x17000, 0x187EC, "9.0"},
+	{0x187ED, 0x187F1, "11.0"},
+	{0x187F2, 0x187F7, "12.0"},
+	{0x18800, 0x18AF2, "9.0"},
+	{0x18AF3, 0x18CD5, "13.0"},
+	{0x18D00, 0x18D08, "13.0"},
+	{0x1AFF0, 0x1AFF3, "14.0"},
+	{0x1AFF5, 0x1AFFB, "14.0"},
+	{0x1AFFD, 0x1AFFE, "14.0"},
+	{0x1B000, 0x1B001, "6.0"},
+	{0x1B002, 0x1B11E, "10.0"},
+	{0x1B11F, 0x1B122, "14.0"},
+	{0x1B150, 0x1B152, "12.0"},
+	{0x1B164, 0x1B167, "12.0"},
+	{0x1B170, 0x1B2FB, "10.0"},
+	{0x1BC00, 0x1BC6A, "7.0"},
+	{0x1BC70, 0x1BC7C, "7.0"},
+	{0x1BC80, 0x1BC88, "7.0"},
+	{0x1BC90, 0x1BC99, "7.0"},
+	{0x1BC9C, 0x1BCA3, "7.0"},
+	{0x1CF00, 0x1CF2D, "14.0"},
+	{0x1CF30, 0x1CF46, "14.0"},
+	{0x1CF50, 0x1CFC3, "14.0"},
+	{0x1D000, 0x1D0F5, "3.1"},
+	{0x1D100, 0x1D126, "3.1"},
+	{0x1D129, 0x1D129, "5.1"},
+	{0x1D12A, 0x1D1DD, "3.1"},
+	{0x1D1DE, 0x1D1E8, "8.0"},
+	{0x1D1E9, 0x1D1EA, "14.0"},
+	{0x1D200, 0x1D245, "4.1"},
+	{0x1D2E0, 0x1D2F3, "11.0"},
+	{0x1D300, 0x1D356, "4.0"},
+	{0x1D360, 0x1D371, "5.0"},
+	{0x1D372, 0x1D378, "11.0"},
+	{0x1D400, 0x1D454, "3.1"},
+	{0x1D456, 0x1D49C, "3.1"},
+	{0x1D49E, 0x1D49F, "3.1"},
+	{0x1D4A2, 0x1D4A2, "3.1"},
+	{0x1D4A5, 0x1D4A6, "3.1"},
+	{0x1D4A9, 0x1D4AC, "3.1"},
+	{0x1D4AE, 0x1D4B9, "3.1"},
+	{0x1D4BB, 0x1D4BB, "3.1"},
+	{0x1D4BD, 0x1D4C0, "3.1"},
+	{0x1D4C1, 0x1D4C1, "4.0"},
+	{0x1D4C2, 0x1D4C3, "3.1"},
+	{0x1D4C5, 0x1D505, "3.1"},
+	{0x1D507, 0x1D50A, "3.1"},
+	{0x1D50D, 0x1D514, "3.1"},
+	{0x1D516, 0x1D51C, "3.1"},
+	{0x1D51E, 0x1D539, "3.1"},
+	{0x1D53B, 0x1D53E, "3.1"},
+	{0x1D540, 0x1D544, "3.1"},
+	{0x1D546, 0x1D546, "3.1"},
+	{0x1D54A, 0x1D550, "3.1"},
+	{0x1D552, 0x1D6A3, "3.1"},
+	{0x1D6A4, 0x1D6A5, "4.1"},
+	{0x1D6A8, 0x1D7C9, "3.1"},
+	{0x1D7CA, 0x1D7CB, "5.0"},
+	{0x1D7CE, 0x1D7FF, "3.1"},
+	{0x1D800, 0x1DA8B, "8.0"},
+	{0x1DA9B, 0x1DA9F, "8.0"},
+	{0x1DAA1, 0x1DAAF, "8.0"},
+	{0x1DF00, 0x1DF1E, "14.0"},
+	{0x1E000, 0x1E006, "9.0"},
+	{0x1E008, 0x1E018, "9.0"},
+	{0x1E01B, 0x1E021, "9.0"},
+	{0x1E023, 0x1E024, "9.0"},
+	{0x1E026, 0x1E02A, "9.0"},
+	{0x1E100, 0x1E12C, "12.0"},
+	{0x1E130, 0x1E13D, "12.0"},
+	{0x1E140, 0x1E149, "12.0"},
+	{0x1E14E, 0x1E14F, "12.0"},
+	{0x1E290, 0x1E2AE, "14.0"},
+	{0x1E2C0, 0x1E2F9, "12.0"},
+	{0x1E2FF, 0x1E2FF, "12.0"},
+	{0x1E7E0, 0x1E7E6, "14.0"},
+	{0x1E7E8, 0x1E7EB, "14.0"},
+	{0x1E7ED, 0x1E7EE, "14.0"},
+	{0x1E7F0, 0x1E7FE, "14.0"},
+	{0x1E800, 0x1E8C4, "7.0"},
+	{0x1E8C7, 0x1E8D6, "7.0"},
+	{0x1E900, 0x1E94A, "9.0"},
+	{0x1E94B, 0x1E94B, "12.0"},
+	{0x1E950, 0x1E959, "9.0"},
+	{0x1E95E, 0x1E95F, "9.0"},
+	{0x1EC71, 0x1ECB4, "11.0"},
+	{0x1ED01, 0x1ED3D, "12.0"},
+	{0x1EE00, 0x1EE03, "6.1"},
+	{0x1EE05, 0x1EE1F, "6.1"},
+	{0x1EE21, 0x1EE22, "6.1"},
+	{0x1EE24, 0x1EE24, "6.1"},
+	{0x1EE27, 0x1EE27, "6.1"},
+	{0x1EE29, 0x1EE32, "6.1"},
+	{0x1EE34, 0x1EE37, "6.1"},
+	{0x1EE39, 0x1EE39, "6.1"},
+	{0x1EE3B, 0x1EE3B, "6.1"},
+	{0x1EE42, 0x1EE42, "6.1"},
+	{0x1EE47, 0x1EE47, "6.1"},
+	{0x1EE49, 0x1EE49, "6.1"},
+	{0x1EE4B, 0x1EE4B, "6.1"},
+	{0x1EE4D, 0x1EE4F, "6.1"},
+	{0x1EE51, 0x1EE52, "6.1"},
+	{0x1EE54, 0x1EE54, "6.1"},
+	{0x1EE57, 0x1EE57, "6.1"},
+	{0x1EE59, 0x1EE59, "6.1"},
+	{0x1EE5B, 0x1EE5B, "6.1"},
+	{0x1EE5D, 0x1EE5D, "6.1"},
+	{0x1EE5F, 0x1EE5F, "6.1"},
+	{0x1EE61, 0x1EE62, "6.1"},
+	{0x1EE64, 0x1EE64, "6.1"},
+	{0x1EE67, 0x1EE6A, "6.1"},
+	{0x1EE6C, 0x1EE72, "6.1"},
+	{0x1EE74, 0x1EE77, "6.1"},
+	{0x1EE79, 0x1EE7C, "6.1"},
+	{0x1EE7E, 0x1EE7E, "6.1"},
+	{0x1EE80, 0x1EE89, "6.1"},
+	{0x1EE8B, 0x1EE9B, "6.1"},
+	{0x1EEA1, 0x1EEA3, "6.1"},
+	{0x1EEA5, 0x1EEA9, "6.1"},
+	{0x1EEAB, 0x1EEBB, "6.1"},
+	{0x1EEF0, 0x1EEF1, "6.1"},
+	{0x1F000, 0x1F02B, "5.1"},
+	{0x1F030, 0x1F093, "5.1"},
+	{0x1F0A0, 0x1F0AE, "6.0"},
+	{0x1F0B1, 0x1F0BE, "6.0"},
+	{0x1F0BF, 0x1F0BF, "7.0"},
+	{0x1F0C1, 0x1F0CF, "6.0"},
+	{0x1F0D1, 0x1F0DF, "6.0"},
+	{0x1F0E0, 0x1F0F5, "7.0"},
+	{0x1F100, 0x1F10A, "5.2"},
+	{0x1F10B, 0x1F10C, "7.0"},
+	{0x1F10D, 0x1F10F, "13.0"},
+	{0x1F110, 0x1F12E, "5.2"},
+	{0x1F12F, 0x1F12F, "11.0"},
+	{0x1F130, 0x1F130, "6.0"},
+	{0x1F131, 0x1F131, "5.2"},
+	{0x1F132, 0x1F13C, "6.0"},
+	{0x1F13D, 0x1F13D, "5.2"},
+	{0x1F13E, 0x1F13E, "6.0"},
+	{0x1F13F, 0x1F13F, "5.2"},
+	{0x1F140, 0x1F141, "6.0"},
+	{0x1F142, 0x1F142, "5.2"},
+	{0x1F143, 0x1F145, "6.0"},
+	{0x1F146, 0x1F146, "5.2"},
+	{0x1F147, 0x1F149, "6.0"},
+	{0x1F14A, 0x1F14E, "5.2"},
+	{0x1F14F, 0x1F156, "6.0"},
+	{0x1F157, 0x1F157, "5.2"},
+	{0x1F158, 0x1F15E, "6.0"},
+	{0x1F15F, 0x1F15F, "5.2"},
+	{0x1F160, 0x1F169, "6.0"},
+	{0x1F16A, 0x1F16B, "6.1"},
+	{0x1F16C, 0x1F16C, "12.0"},
+	{0x1F16D, 0x1F16F, "13.0"},
+	{0x1F170, 0x1F178, "6.0"},
+	{0x1F179, 0x1F179, "5.2"},
+	{0x1F17A, 0x1F17A, "6.0"},
+	{0x1F17B, 0x1F17C, "5.2"},
+	{0x1F17D, 0x1F17E, "6.0"},
+	{0x1F17F, 0x1F17F, "5.2"},
+	{0x1F180, 0x1F189, "6.0"},
+	{0x1F18A, 0x1F18D, "5.2"},
+	{0x1F18E, 0x1F18F, "6.0"},
+	{0x1F190, 0x1F190, "5.2"},
+	{0x1F191, 0x1F19A, "6.0"},
+	{0x1F19B, 0x1F1AC, "9.0"},
+	{0x1F1AD, 0x1F1AD, "13.0"},
+	{0x1F1E6, 0x1F1FF, "6.0"},
+	{0x1F200, 0x1F200, "5.2"},
+	{0x1F201, 0x1F202, "6.0"},
+	{0x1F210, 0x1F231, "5.2"},
+	{0x1F232, 0x1F23A, "6.0"},
+	{0x1F23B, 0x1F23B, "9.0"},
+	{0x1F240, 0x1F248, "5.2"},
+	{0x1F250, 0x1F251, "6.0"},
+	{0x1F260, 0x1F265, "10.0"},
+	{0x1F300, 0x1F320, "6.0"},
+	{0x1F321, 0x1F32C, "7.0"},
+	{0x1F32D, 0x1F32F, "8.0"},
+	{0x1F330, 0x1F335, "6.0"},
+	{0x1F336, 0x1F336, "7.0"},
+	{0x1F337, 0x1F37C, "6.0"},
+	{0x1F37D, 0x1F37D, "7.0"},
+	{0x1F37E, 0x1F37F, "8.0"},
+	{0x1F380, 0x1F393, "6.0"},
+	{0x1F394, 0x1F39F, "7.0"},
+	{0x1F3A0, 0x1F3C4, "6.0"},
+	{0x1F3C5, 0x1F3C5, "7.0"},
+	{0x1F3C6, 0x1F3CA, "6.0"},
+	{0x1F3CB, 0x1F3CE, "7.0"},
+	{0x1F3CF, 0x1F3D3, "8.0"},
+	{0x1F3D4, 0x1F3DF, "7.0"},
+	{0x1F3E0, 0x1F3F0, "6.0"},
+	{0x1F3F1, 0x1F3F7, "7.0"},
+	{0x1F3F8, 0x1F3FF, "8.0"},
+	{0x1F400, 0x1F43E, "6.0"},
+	{0x1F43F, 0x1F43F, "7.0"},
+	{0x1F440, 0x1F440, "6.0"},
+	{0x1F441, 0x1F441, "7.0"},
+	{0x1F442, 0x1F4F7, "6.0"},
+	{0x1F4F8, 0x1F4F8, "7.0"},
+	{0x1F4F9, 0x1F4FC, "6.0"},
+	{0x1F4FD, 0x1F4FE, "7.0"},
+	{0x1F4FF, 0x1F4FF, "8.0"},
+	{0x1F500, 0x1F53D, "6.0"},
+	{0x1F53E, 0x1F53F, "7.0"},
+	{0x1F540, 0x1F543, "6.1"},
+	{0x1F544, 0x1F54A, "7.0"},
+	{0x1F54B, 0x1F54F, "8.0"},
+	{0x1F550, 0x1F567, "6.0"},
+	{0x1F568, 0x1F579, "7.0"},
+	{0x1F57A, 0x1F57A, "9.0"},
+	{0x1F57B, 0x1F5A3, "7.0"},
+	{0x1F5A4, 0x1F5A4, "9.0"},
+	{0x1F5A5, 0x1F5FA, "7.0"},
+	{0x1F5FB, 0x1F5FF, "6.0"},
+	{0x1F600, 0x1F600, "6.1"},
+	{0x1F601, 0x1F610, "6.0"},
+	{0x1F611, 0x1F611, "6.1"},
+	{0x1F612, 0x1F614, "6.0"},
+	{0x1F615, 0x1F615, "6.1"},
+	{0x1F616, 0x1F616, "6.0"},
+	{0x1F617, 0x1F617, "6.1"},
+	{0x1F618, 0x1F618, "6.0"},
+	{0x1F619, 0x1F619, "6.1"},
+	{0x1F61A, 0x1F61A, "6.0"},
+	{0x1F61B, 0x1F61B, "6.1"},
+	{0x1F61C, 0x1F61E, "6.0"},
+	{0x1F61F, 0x1F61F, "6.1"},
+	{0x1F620, 0x1F625, "6.0"},
+	{0x1F626, 0x1F627, "6.1"},
+	{0x1F628, 0x1F62B, "6.0"},
+	{0x1F62C, 0x1F62C, "6.1"},
+	{0x1F62D, 0x1F62D, "6.0"},
+	{0x1F62E, 0x1F62F, "6.1"},
+	{0x1F630, 0x1F633, "6.0"},
+	{0x1F634, 0x1F634, "6.1"},
+	{0x1F635, 0x1F640, "6.0"},
+	{0x1F641, 0x1F642, "7.0"},
+	{0x1F643, 0x1F644, "8.0"},
+	{0x1F645, 0x1F64F, "6.0"},
+	{0x1F650, 0x1F67F, "7.0"},
+	{0x1F680, 0x1F6C5, "6.0"},
+	{0x1F6C6, 0x1F6CF, "7.0"},
+	{0x1F6D0, 0x1F6D0, "8.0"},
+	{0x1F6D1, 0x1F6D2, "9.0"},
+	{0x1F6D3, 0x1F6D4, "10.0"},
+	{0x1F6D5, 0x1F6D5, "12.0"},
+	{0x1F6D6, 0x1F6D7, "13.0"},
+	{0x1F6DD, 0x1F6DF, "14.0"},
+	{0x1F6E0, 0x1F6EC, "7.0"},
+	{0x1F6F0, 0x1F6F3, "7.0"},
+	{0x1F6F4, 0x1F6F6, "9.0"},
+	{0x1F6F7, 0x1F6F8, "10.0"},
+	{0x1F6F9, 0x1F6F9, "11.0"},
+	{0x1F6FA, 0x1F6FA, "12.0"},
+	{0x1F6FB, 0x1F6FC, "13.0"},
+	{0x1F700, 0x1F773, "6.0"},
+	{0x1F780, 0x1F7D4, "7.0"},
+	{0x1F7D5, 0x1F7D8, "11.0"},
+	{0x1F7E0, 0x1F7EB, "12.0"},
+	{0x1F7F0, 0x1F7F0, "14.0"},
+	{0x1F800, 0x1F80B, "7.0"},
+	{0x1F810, 0x1F847, "7.0"},
+	{0x1F850, 0x1F859, "7.0"},
+	{0x1F860, 0x1F887, "7.0"},
+	{0x1F890, 0x1F8AD, "7.0"},
+	{0x1F8B0, 0x1F8B1, "13.0"},
+	{0x1F900, 0x1F90B, "10.0"},
+	{0x1F90C, 0x1F90C, "13.0"},
+	{0x1F90D, 0x1F90F, "12.0"},
+	{0x1F910, 0x1F918, "8.0"},
+	{0x1F919, 0x1F91E, "9.0"},
+	{0x1F91F, 0x1F91F, "10.0"},
+	{0x1F920, 0x1F927, "9.0"},
+	{0x1F928, 0x1F92F, "10.0"},
+	{0x1F930, 0x1F930, "9.0"},
+	{0x1F931, 0x1F932, "10.0"},
+	{0x1F933, 0x1F93E, "9.0"},
+	{0x1F93F, 0x1F93F, "12.0"},
+	{0x1F940, 0x1F94B, "9.0"},
+	{0x1F94C, 0x1F94C, "10.0"},
+	{0x1F94D, 0x1F94F, "11.0"},
+	{0x1F950, 0x1F95E, "9.0"},
+	{0x1F95F, 0x1F96B, "10.0"},
+	{0x1F96C, 0x1F970, "11.0"},
+	{0x1F971, 0x1F971, "12.0"},
+	{0x1F972, 0x1F972, "13.0"},
+	{0x1F973, 0x1F976, "11.0"},
+	{0x1F977, 0x1F978, "13.0"},
+	{0x1F979, 0x1F979, "14.0"},
+	{0x1F97A, 0x1F97A, "11.0"},
+	{0x1F97B, 0x1F97B, "12.0"},
+	{0x1F97C, 0x1F97F, "11.0"},
+	{0x1F980, 0x1F984, "8.0"},
+	{0x1F985, 0x1F991, "9.0"},
+	{0x1F992, 0x1F997, "10.0"},
+	{0x1F998, 0x1F9A2, "11.0"},
+	{0x1F9A3, 0x1F9A4, "13.0"},
+	{0x1F9A5, 0x1F9AA, "12.0"},
+	{0x1F9AB, 0x1F9AD, "13.0"},
+	{0x1F9AE, 0x1F9AF, "12.0"},
+	{0x1F9B0, 0x1F9B9, "11.0"},
+	{0x1F9BA, 0x1F9BF, "12.0"},
+	{0x1F9C0, 0x1F9C0, "8.0"},
+	{0x1F9C1, 0x1F9C2, "11.0"},
+	{0x1F9C3, 0x1F9CA, "12.0"},
+	{0x1F9CB, 0x1F9CB, "13.0"},
+	{0x1F9CC, 0x1F9CC, "14.0"},
+	{0x1F9CD, 0x1F9CF, "12.0"},
+	{0x1F9D0, 0x1F9E6, "10.0"},
+	{0x1F9E7, 0x1F9FF, "11.0"},
+	{0x1FA00, 0x1FA53, "12.0"},
+	{0x1FA60, 0x1FA6D, "11.0"},
+	{0x1FA70, 0x1FA73, "12.0"},
+	{0x1FA74, 0x1FA74, "13.0"},
+	{0x1FA78, 0x1FA7A, "12.0"},
+	{0x1FA7B, 0x1FA7C, "14.0"},
+	{0x1FA80, 0x1FA82, "12.0"},
+	{0x1FA83, 0x1FA86, "13.0"},
+	{0x1FA90, 0x1FA95, "12.0"},
+	{0x1FA96, 0x1FAA8, "13.0"},
+	{0x1FAA9, 0x1FAAC, "14.0"},
+	{0x1FAB0, 0x1FAB6, "13.0"},
+	{0x1FAB7, 0x1FABA, "14.0"},
+	{0x1FAC0, 0x1FAC2, "13.0"},
+	{0x1FAC3, 0x1FAC5, "14.0"},
+	{0x1FAD0, 0x1FAD6, "13.0"},
+	{0x1FAD7, 0x1FAD9, "14.0"},
+	{0x1FAE0, 0x1FAE7, "14.0"},
+	{0x1FAF0, 0x1FAF6, "14.0"},
+	{0x1FB00, 0x1FB92, "13.0"},
+	{0x1FB94, 0x1FBCA, "13.0"},
+	{0x1FBF0, 0x1FBF9, "13.0"},
+	{0x1FFFE, 0x1FFFF, "2.0"},
+	{0x20000, 0x2A6D6, "3.1"},
+	{0x2A6D7, 0x2A6DD, "13.0"},
+	{0x2A6DE, 0x2A6DF, "14.0"},
+	{0x2A700, 0x2B734, "5.2"},
+	{0x2B735, 0x2B738, "14.0"},
+	{0x2B740, 0x2B81D, "6.0"},
+	{0x2B820, 0x2CEA1, "8.0"},
+	{0x2CEB0, 0x2EBE0, "10.0"},
+	{0x2F800, 0x2FA1D, "3.1"},
+	{0x2FFFE, 0x2FFFF, "2.0"},
+	{0x30000, 0x3134A, "13.0"},
+	{0x3FFFE, 0x3FFFF, "2.0"},
+	{0x4FFFE, 0x4FFFF, "2.0"},
+	{0x5FFFE, 0x5FFFF, "2.0"},
+	{0x6FFFE, 0x6FFFF, "2.0"},
+	{0x7FFFE, 0x7FFFF, "2.0"},
+	{0x8FFFE, 0x8FFFF, "2.0"},
+	{0x9FFFE, 0x9FFFF, "2.0"},
+	{0xAFFFE, 0xAFFFF, "2.0"},
+	{0xBFFFE, 0xBFFFF, "2.0"},
+	{0xCFFFE, 0xCFFFF, "2.0"},
+	{0xDFFFE, 0xDFFFF, "2.0"},
+	{0xE0001, 0xE0001, "3.1"},
+	{0xE0020, 0xE007F, "3.1"},
+	{0xE0100, 0xE01EF, "4.0"},
+	{0xEFFFE, 0x10FFFF, "2.0"},
+}
+
+// properties are the binary properties listed in PropList.txt.
+var properties = map[string]*unicode.RangeTable{
+	"ASCII_Hex_Digit": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0030, 0x0039, 1},
+			{0x0041, 0x0046, 1},
+			{0x0061, 0x0066, 1},
+		},
+		LatinOffset: 3,
+	},
+	"Bidi_Control": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x061c, 0x061c, 1},
+			{0x200e, 0x200f, 1},
+			{0x202a, 0x202e, 1},
+			{0x2066, 0x2069, 1},
+		},
+	},
+	"Dash": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x002d, 0x002d, 1},
+			{0x058a, 0x058a, 1},
+			{0x05be, 0x05be, 1},
+			{0x1400, 0x1400, 1},
+			{0x1806, 0x1806, 1},
+			{0x2010, 0x2015, 1},
+			{0x2053, 0x2053, 1},
+			{0x207b, 0x207b, 1},
+			{0x208b, 0x208b, 1},
+			{0x2212, 0x2212, 1},
+			{0x2e17, 0x2e17, 1},
+			{0x2e1a, 0x2e1a, 1},
+			{0x2e3a, 0x2e3b, 1},
+			{0x2e40, 0x2e40, 1},
+			{0x2e5d, 0x2e5d, 1},
+			{0x301c, 0x301c, 1},
+			{0x3030, 0x3030, 1},
+			{0x30a0, 0x30a0, 1},
+			{0xfe31, 0xfe32, 1},
+			{0xfe58, 0xfe58, 1},
+			{0xfe63, 0xfe63, 1},
+			{0xff0d, 0xff0d, 1},
+		},
+		R32: []unicode.Range32{
+			{0x10ead, 0x10ead, 1},
+		},
+		LatinOffset: 1,
+	},
+	"Deprecated": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0149, 0x0149, 1},
+			{0x0673, 0x0673, 1},
+			{0x0f77, 0x0f77, 1},
+			{0x0f79, 0x0f79, 1},
+			{0x17a3, 0x17a4, 1},
+			{0x206a, 0x206f, 1},
+			{0x2329, 0x232a, 1},
+		},
+		R32: []unicode.Range32{
+			{0xe0001, 0xe0001, 1},
+		},
+	},
+	"Diacritic": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x005e, 0x005e, 1},
+			{0x0060, 0x0060, 1},
+			{0x00a8, 0x00a8, 1},
+			{0x00af, 0x00af, 1},
+			{0x00b4, 0x00b4, 1},
+			{0x00b7, 0x00b8, 1},
+			{0x02b0, 0x034e, 1},
+			{0x0350, 0x0357, 1},
+			{0x035d, 0x0362, 1},
+			{0x0374, 0x0375, 1},
+			{0x037a, 0x037a, 1},
+			{0x0384, 0x0385, 1},
+			{0x0483, 0x0487, 1},
+			{0x0559, 0x0559, 1},
+			{0x0591, 0x05a1, 1},
+			{0x05a3, 0x05bd, 1},
+			{0x05bf, 0x05bf, 1},
+			{0x05c1, 0x05c2, 1},
+			{0x05c4, 0x05c4, 1},
+			{0x064b, 0x0652, 1},
+			{0x0657, 0x0658, 1},
+			{0x06df, 0x06e0, 1},
+			{0x06e5, 0x06e6, 1},
+			{0x06ea, 0x06ec, 1},
+			{0x0730, 0x074a, 1},
+			{0x07a6, 0x07b0, 1},
+			{0x07eb, 0x07f5, 1},
+			{0x0818, 0x0819, 1},
+			{0x0898, 0x089f, 1},
+			{0x08c9, 0x08d2, 1},
+			{0x08e3, 0x08fe, 1},
+			{0x093c, 0x093c, 1},
+			{0x094d, 0x094d, 1},
+			{0x0951, 0x0954, 1},
+			{0x0971, 0x0971, 1},
+			{0x09bc, 0x09bc, 1},
+			{0x09cd, 0x09cd, 1},
+			{0x0a3c, 0x0a3c, 1},
+			{0x0a4d, 0x0a4d, 1},
+			{0x0abc, 0x0abc, 1},
+			{0x0acd, 0x0acd, 1},
+			{0x0afd, 0x0aff, 1},
+			{0x0b3c, 0x0b3c, 1},
+			{0x0b4d, 0x0b4d, 1},
+			{0x0b55, 0x0b55, 1},
+			{0x0bcd, 0x0bcd, 1},
+			{0x0c3c, 0x0c3c, 1},
+			{0x0c4d, 0x0c4d, 1},
+			{0x0cbc, 0x0cbc, 1},
+			{0x0ccd, 0x0ccd, 1},
+			{0x0d3b, 0x0d3c, 1},
+			{0x0d4d, 0x0d4d, 1},
+			{0x0dca, 0x0dca, 1},
+			{0x0e47, 0x0e4c, 1},
+			{0x0e4e, 0x0e4e, 1},
+			{0x0eba, 0x0eba, 1},
+			{0x0ec8, 0x0ecc, 1},
+			{0x0f18, 0x0f19, 1},
+			{0x0f35, 0x0f35, 1},
+			{0x0f37, 0x0f37, 1},
+			{0x0f39, 0x0f39, 1},
+			{0x0f3e, 0x0f3f, 1},
+			{0x0f82, 0x0f84, 1},
+			{0x0f86, 0x0f87, 1},
+			{0x0fc6, 0x0fc6, 1},
+			{0x1037, 0x1037, 1},
+			{0x1039, 0x103a, 1},
+			{0x1063, 0x1064, 1},
+			{0x1069, 0x106d, 1},
+			{0x1087, 0x108d, 1},
+			{0x108f, 0x108f, 1},
+			{0x109a, 0x109b, 1},
+			{0x135d, 0x135f, 1},
+			{0x1714, 0x1715, 1},
+			{0x17c9, 0x17d3, 1},
+			{0x17dd, 0x17dd, 1},
+			{0x1939, 0x193b, 1},
+			{0x1a75, 0x1a7c, 1},
+			{0x1a7f, 0x1a7f, 1},
+			{0x1ab0, 0x1abe, 1},
+			{0x1ac1, 0x1acb, 1},
+			{0x1b34, 0x1b34, 1},
+			{0x1b44, 0x1b44, 1},
+			{0x1b6b, 0x1b73, 1},
+			{0x1baa, 0x1bab, 1},
+			{0x1c36, 0x1c37, 1},
+			{0x1c78, 0x1c7d, 1},
+			{0x1cd0, 0x1ce8, 1},
+			{0x1ced, 0x1ced, 1},
+			{0x1cf4, 0x1cf4, 1},
+			{0x1cf7, 0x1cf9, 1},
+			{0x1d2c, 0x1d6a, 1},
+			{0x1dc4, 0x1dcf, 1},
+			{0x1df5, 0x1dff, 1},
+			{0x1fbd, 0x1fbd, 1},
+			{0x1fbf, 0x1fc1, 1},
+			{0x1fcd, 0x1fcf, 1},
+			{0x1fdd, 0x1fdf, 1},
+			{0x1fed, 0x1fef, 1},
+			{0x1ffd, 0x1ffe, 1},
+			{0x2cef, 0x2cf1, 1},
+			{0x2e2f, 0x2e2f, 1},
+			{0x302a, 0x302f, 1},
+			{0x3099, 0x309c, 1},
+			{0x30fc, 0x30fc, 1},
+			{0xa66f, 0xa66f, 1},
+			{0xa67c, 0xa67d, 1},
+			{0xa67f, 0xa67f, 1},
+			{0xa69c, 0xa69d, 1},
+			{0xa6f0, 0xa6f1, 1},
+			{0xa700, 0xa721, 1},
+			{0xa788, 0xa78a, 1},
+			{0xa7f8, 0xa7f9, 1},
+			{0xa8c4, 0xa8c4, 1},
+			{0xa8e0, 0xa8f1, 1},
+			{0xa92b, 0xa92e, 1},
+			{0xa953, 0xa953, 1},
+			{0xa9b3, 0xa9b3, 1},
+			{0xa9c0, 0xa9c0, 1},
+			{0xa9e5, 0xa9e5, 1},
+			{0xaa7b, 0xaa7d, 1},
+			{0xaabf, 0xaac2, 1},
+			{0xaaf6, 0xaaf6, 1},
+			{0xab5b, 0xab5f, 1},
+			{0xab69, 0xab6b, 1},
+			{0xabec, 0xabed, 1},
+			{0xfb1e, 0xfb1e, 1},
+			{0xfe20, 0xfe2f, 1},
+			{0xff3e, 0xff3e, 1},
+			{0xff40, 0xff40, 1},
+			{0xff70, 0xff70, 1},
+			{0xff9e, 0xff9f, 1},
+			{0xffe3, 0xffe3, 1},
+		},
+		R32: []unicode.Range32{
+			{0x102e0, 0x102e0, 1},
+			{0x10780, 0x10785, 1},
+			{0x10787, 0x107b0, 1},
+			{0x107b2, 0x107ba, 1},
+			{0x10ae5, 0x10ae6, 1},
+			{0x10d22, 0x10d27, 1},
+			{0x10f46, 0x10f50, 1},
+			{0x10f82, 0x10f85, 1},
+			{0x11046, 0x11046, 1},
+			{0x11070, 0x11070, 1},
+			{0x110b9, 0x110ba, 1},
+			{0x11133, 0x11134, 1},
+			{0x11173, 0x11173, 1},
+			{0x111c0, 0x111c0, 1},
+			{0x111ca, 0x111cc, 1},
+			{0x11235, 0x11236, 1},
+			{0x112e9, 0x112ea, 1},
+			{0x1133c, 0x1133c, 1},
+			{0x1134d, 0x1134d, 1},
+			{0x11366, 0x1136c, 1},
+			{0x11370, 0x11374, 1},
+			{0x11442, 0x11442, 1},
+			{0x11446, 0x11446, 1},
+			{0x114c2, 0x114c3, 1},
+			{0x115bf, 0x115c0, 1},
+			{0x1163f, 0x1163f, 1},
+			{0x116b6, 0x116b7, 1},
+			{0x1172b, 0x1172b, 1},
+			{0x11839, 0x1183a, 1},
+			{0x1193d, 0x1193e, 1},
+			{0x11943, 0x11943, 1},
+			{0x119e0, 0x119e0, 1},
+			{0x11a34, 0x11a34, 1},
+			{0x11a47, 0x11a47, 1},
+			{0x11a99, 0x11a99, 1},
+			{0x11c3f, 0x11c3f, 1},
+			{0x11d42, 0x11d42, 1},
+			{0x11d44, 0x11d45, 1},
+			{0x11d97, 0x11d97, 1},
+			{0x16af0, 0x16af4, 1},
+			{0x16b30, 0x16b36, 1},
+			{0x16f8f, 0x16f9f, 1},
+			{0x16ff0, 0x16ff1, 1},
+			{0x1aff0, 0x1aff3, 1},
+			{0x1aff5, 0x1affb, 1},
+			{0x1affd, 0x1affe, 1},
+			{0x1cf00, 0x1cf2d, 1},
+			{0x1cf30, 0x1cf46, 1},
+			{0x1d167, 0x1d169, 1},
+			{0x1d16d, 0x1d172, 1},
+			{0x1d17b, 0x1d182, 1},
+			{0x1d185, 0x1d18b, 1},
+			{0x1d1aa, 0x1d1ad, 1},
+			{0x1e130, 0x1e136, 1},
+			{0x1e2ae, 0x1e2ae, 1},
+			{0x1e2ec, 0x1e2ef, 1},
+			{0x1e8d0, 0x1e8d6, 1},
+			{0x1e944, 0x1e946, 1},
+			{0x1e948, 0x1e94a, 1},
+		},
+		LatinOffset: 6,
+	},
+	"Extender": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x00b7, 0x00b7, 1},
+			{0x02d0, 0x02d1, 1},
+			{0x0640, 0x0640, 1},
+			{0x07fa, 0x07fa, 1},
+			{0x0b55, 0x0b55, 1},
+			{0x0e46, 0x0e46, 1},
+			{0x0ec6, 0x0ec6, 1},
+			{0x180a, 0x180a, 1},
+			{0x1843, 0x1843, 1},
+			{0x1aa7, 0x1aa7, 1},
+			{0x1c36, 0x1c36, 1},
+			{0x1c7b, 0x1c7b, 1},
+			{0x3005, 0x3005, 1},
+			{0x3031, 0x3035, 1},
+			{0x309d, 0x309e, 1},
+			{0x30fc, 0x30fe, 1},
+			{0xa015, 0xa015, 1},
+			{0xa60c, 0xa60c, 1},
+			{0xa9cf, 0xa9cf, 1},
+			{0xa9e6, 0xa9e6, 1},
+			{0xaa70, 0xaa70, 1},
+			{0xaadd, 0xaadd, 1},
+			{0xaaf3, 0xaaf4, 1},
+			{0xff70, 0xff70, 1},
+		},
+		R32: []unicode.Range32{
+			{0x10781, 0x10782, 1},
+			{0x1135d, 0x1135d, 1},
+			{0x115c6, 0x115c8, 1},
+			{0x11a98, 0x11a98, 1},
+			{0x16b42, 0x16b43, 1},
+			{0x16fe0, 0x16fe1, 1},
+			{0x16fe3, 0x16fe3, 1},
+			{0x1e13c, 0x1e13d, 1},
+			{0x1e944, 0x1e946, 1},
+		},
+		LatinOffset: 1,
+	},
+	"Hex_Digit": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0030, 0x0039, 1},
+			{0x0041, 0x0046, 1},
+			{0x0061, 0x0066, 1},
+			{0xff10, 0xff19, 1},
+			{0xff21, 0xff26, 1},
+			{0xff41, 0xff46, 1},
+		},
+		LatinOffset: 3,
+	},
+	"Hyphen": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x002d, 0x002d, 1},
+			{0x00ad, 0x00ad, 1},
+			{0x058a, 0x058a, 1},
+			{0x1806, 0x1806, 1},
+			{0x2010, 0x2011, 1},
+			{0x2e17, 0x2e17, 1},
+			{0x30fb, 0x30fb, 1},
+			{0xfe63, 0xfe63, 1},
+			{0xff0d, 0xff0d, 1},
+			{0xff65, 0xff65, 1},
+		},
+		LatinOffset: 2,
+	},
+	"IDS_Binary_Operator": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x2ff0, 0x2ff1, 1},
+			{0x2ff4, 0x2ffb, 1},
+		},
+	},
+	"IDS_Trinary_Operator": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x2ff2, 0x2ff3, 1},
+		},
+	},
+	"Ideographic": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x3006, 0x3007, 1},
+			{0x3021, 0x3029, 1},
+			{0x3038, 0x303a, 1},
+			{0x3400, 0x4dbf, 1},
+			{0x4e00, 0x9fff, 1},
+			{0xf900, 0xfa6d, 1},
+			{0xfa70, 0xfad9, 1},
+		},
+		R32: []unicode.Range32{
+			{0x16fe4, 0x16fe4, 1},
+			{0x17000, 0x187f7, 1},
+			{0x18800, 0x18cd5, 1},
+			{0x18d00, 0x18d08, 1},
+			{0x1b170, 0x1b2fb, 1},
+			{0x20000, 0x2a6df, 1},
+			{0x2a700, 0x2b738, 1},
+			{0x2b740, 0x2b81d, 1},
+			{0x2b820, 0x2cea1, 1},
+			{0x2ceb0, 0x2ebe0, 1},
+			{0x2f800, 0x2fa1d, 1},
+			{0x30000, 0x3134a, 1},
+		},
+	},
+	"Join_Control": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x200c, 0x200d, 1},
+		},
+	},
+	"Logical_Order_Exception": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0e40, 0x0e44, 1},
+			{0x0ec0, 0x0ec4, 1},
+			{0x19b5, 0x19b7, 1},
+			{0x19ba, 0x19ba, 1},
+			{0xaab5, 0xaab6, 1},
+			{0xaab9, 0xaab9, 1},
+			{0xaabb, 0xaabc, 1},
+		},
+	},
+	"Noncharacter_Code_Point": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0xfdd0, 0xfdef, 1},
+			{0xfffe, 0xffff, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1fffe, 0x1ffff, 1},
+			{0x2fffe, 0x2ffff, 1},
+			{0x3fffe, 0x3ffff, 1},
+			{0x4fffe, 0x4ffff, 1},
+			{0x5fffe, 0x5ffff, 1},
+			{0x6fffe, 0x6ffff, 1},
+			{0x7fffe, 0x7ffff, 1},
+			{0x8fffe, 0x8ffff, 1},
+			{0x9fffe, 0x9ffff, 1},
+			{0xafffe, 0xaffff, 1},
+			{0xbfffe, 0xbffff, 1},
+			{0xcfffe, 0xcffff, 1},
+			{0xdfffe, 0xdffff, 1},
+			{0xefffe, 0xeffff, 1},
+			{0xffffe, 0xfffff, 1},
+			{0x10fffe, 0x10ffff, 1},
+		},
+	},
+	"Other_Alphabetic": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0345, 0x0345, 1},
+			{0x05b0, 0x05bd, 1},
+			{0x05bf, 0x05bf, 1},
+			{0x05c1, 0x05c2, 1},
+			{0x05c4, 0x05c5, 1},
+			{0x05c7, 0x05c7, 1},
+			{0x0610, 0x061a, 1},
+			{0x064b, 0x0657, 1},
+			{0x0659, 0x065f, 1},
+			{0x0670, 0x0670, 1},
+			{0x06d6, 0x06dc, 1},
+			{0x06e1, 0x06e4, 1},
+			{0x06e7, 0x06e8, 1},
+			{0x06ed, 0x06ed, 1},
+			{0x0711, 0x0711, 1},
+			{0x0730, 0x073f, 1},
+			{0x07a6, 0x07b0, 1},
+			{0x0816, 0x0817, 1},
+			{0x081b, 0x0823, 1},
+			{0x0825, 0x0827, 1},
+			{0x0829, 0x082c, 1},
+			{0x08d4, 0x08df, 1},
+			{0x08e3, 0x08e9, 1},
+			{0x08f0, 0x0903, 1},
+			{0x093a, 0x093b, 1},
+			{0x093e, 0x094c, 1},
+			{0x094e, 0x094f, 1},
+			{0x0955, 0x0957, 1},
+			{0x0962, 0x0963, 1},
+			{0x0981, 0x0983, 1},
+			{0x09be, 0x09c4, 1},
+			{0x09c7, 0x09c8, 1},
+			{0x09cb, 0x09cc, 1},
+			{0x09d7, 0x09d7, 1},
+			{0x09e2, 0x09e3, 1},
+			{0x0a01, 0x0a03, 1},
+			{0x0a3e, 0x0a42, 1},
+			{0x0a47, 0x0a48, 1},
+			{0x0a4b, 0x0a4c, 1},
+			{0x0a51, 0x0a51, 1},
+			{0x0a70, 0x0a71, 1},
+			{0x0a75, 0x0a75, 1},
+			{0x0a81, 0x0a83, 1},
+			{0x0abe, 0x0ac5, 1},
+			{0x0ac7, 0x0ac9, 1},
+			{0x0acb, 0x0acc, 1},
+			{0x0ae2, 0x0ae3, 1},
+			{0x0afa, 0x0afc, 1},
+			{0x0b01, 0x0b03, 1},
+			{0x0b3e, 0x0b44, 1},
+			{0x0b47, 0x0b48, 1},
+			{0x0b4b, 0x0b4c, 1},
+			{0x0b56, 0x0b57, 1},
+			{0x0b62, 0x0b63, 1},
+			{0x0b82, 0x0b82, 1},
+			{0x0bbe, 0x0bc2, 1},
+			{0x0bc6, 0x0bc8, 1},
+			{0x0bca, 0x0bcc, 1},
+			{0x0bd7, 0x0bd7, 1},
+			{0x0c00, 0x0c03, 1},
+			{0x0c3e, 0x0c44, 1},
+			{0x0c46, 0x0c48, 1},
+			{0x0c4a, 0x0c4c, 1},
+			{0x0c55, 0x0c56, 1},
+			{0x0c62, 0x0c63, 1},
+			{0x0c81, 0x0c83, 1},
+			{0x0cbe, 0x0cc4, 1},
+			{0x0cc6, 0x0cc8, 1},
+			{0x0cca, 0x0ccc, 1},
+			{0x0cd5, 0x0cd6, 1},
+			{0x0ce2, 0x0ce3, 1},
+			{0x0d00, 0x0d03, 1},
+			{0x0d3e, 0x0d44, 1},
+			{0x0d46, 0x0d48, 1},
+			{0x0d4a, 0x0d4c, 1},
+			{0x0d57, 0x0d57, 1},
+			{0x0d62, 0x0d63, 1},
+			{0x0d81, 0x0d83, 1},
+			{0x0dcf, 0x0dd4, 1},
+			{0x0dd6, 0x0dd6, 1},
+			{0x0dd8, 0x0ddf, 1},
+			{0x0df2, 0x0df3, 1},
+			{0x0e31, 0x0e31, 1},
+			{0x0e34, 0x0e3a, 1},
+			{0x0e4d, 0x0e4d, 1},
+			{0x0eb1, 0x0eb1, 1},
+			{0x0eb4, 0x0eb9, 1},
+			{0x0ebb, 0x0ebc, 1},
+			{0x0ecd, 0x0ecd, 1},
+			{0x0f71, 0x0f81, 1},
+			{0x0f8d, 0x0f97, 1},
+			{0x0f99, 0x0fbc, 1},
+			{0x102b, 0x1036, 1},
+			{0x1038, 0x1038, 1},
+			{0x103b, 0x103e, 1},
+			{0x1056, 0x1059, 1},
+			{0x105e, 0x1060, 1},
+			{0x1062, 0x1064, 1},
+			{0x1067, 0x106d, 1},
+			{0x1071, 0x1074, 1},
+			{0x1082, 0x108d, 1},
+			{0x108f, 0x108f, 1},
+			{0x109a, 0x109d, 1},
+			{0x1712, 0x1713, 1},
+			{0x1732, 0x1733, 1},
+			{0x1752, 0x1753, 1},
+			{0x1772, 0x1773, 1},
+			{0x17b6, 0x17c8, 1},
+			{0x1885, 0x1886, 1},
+			{0x18a9, 0x18a9, 1},
+			{0x1920, 0x192b, 1},
+			{0x1930, 0x1938, 1},
+			{0x1a17, 0x1a1b, 1},
+			{0x1a55, 0x1a5e, 1},
+			{0x1a61, 0x1a74, 1},
+			{0x1abf, 0x1ac0, 1},
+			{0x1acc, 0x1ace, 1},
+			{0x1b00, 0x1b04, 1},
+			{0x1b35, 0x1b43, 1},
+			{0x1b80, 0x1b82, 1},
+			{0x1ba1, 0x1ba9, 1},
+			{0x1bac, 0x1bad, 1},
+			{0x1be7, 0x1bf1, 1},
+			{0x1c24, 0x1c36, 1},
+			{0x1de7, 0x1df4, 1},
+			{0x24b6, 0x24e9, 1},
+			{0x2de0, 0x2dff, 1},
+			{0xa674, 0xa67b, 1},
+			{0xa69e, 0xa69f, 1},
+			{0xa802, 0xa802, 1},
+			{0xa80b, 0xa80b, 1},
+			{0xa823, 0xa827, 1},
+			{0xa880, 0xa881, 1},
+			{0xa8b4, 0xa8c3, 1},
+			{0xa8c5, 0xa8c5, 1},
+			{0xa8ff, 0xa8ff, 1},
+			{0xa926, 0xa92a, 1},
+			{0xa947, 0xa952, 1},
+			{0xa980, 0xa983, 1},
+			{0xa9b4, 0xa9bf, 1},
+			{0xa9e5, 0xa9e5, 1},
+			{0xaa29, 0xaa36, 1},
+			{0xaa43, 0xaa43, 1},
+			{0xaa4c, 0xaa4d, 1},
+			{0xaa7b, 0xaa7d, 1},
+			{0xaab0, 0xaab0, 1},
+			{0xaab2, 0xaab4, 1},
+			{0xaab7, 0xaab8, 1},
+			{0xaabe, 0xaabe, 1},
+			{0xaaeb, 0xaaef, 1},
+			{0xaaf5, 0xaaf5, 1},
+			{0xabe3, 0xabea, 1},
+			{0xfb1e, 0xfb1e, 1},
+		},
+		R32: []unicode.Range32{
+			{0x10376, 0x1037a, 1},
+			{0x10a01, 0x10a03, 1},
+			{0x10a05, 0x10a06, 1},
+			{0x10a0c, 0x10a0f, 1},
+			{0x10d24, 0x10d27, 1},
+			{0x10eab, 0x10eac, 1},
+			{0x11000, 0x11002, 1},
+			{0x11038, 0x11045, 1},
+			{0x11073, 0x11074, 1},
+			{0x11082, 0x11082, 1},
+			{0x110b0, 0x110b8, 1},
+			{0x110c2, 0x110c2, 1},
+			{0x11100, 0x11102, 1},
+			{0x11127, 0x11132, 1},
+			{0x11145, 0x11146, 1},
+			{0x11180, 0x11182, 1},
+			{0x111b3, 0x111bf, 1},
+			{0x111ce, 0x111cf, 1},
+			{0x1122c, 0x11234, 1},
+			{0x11237, 0x11237, 1},
+			{0x1123e, 0x1123e, 1},
+			{0x112df, 0x112e8, 1},
+			{0x11300, 0x11303, 1},
+			{0x1133e, 0x11344, 1},
+			{0x11347, 0x11348, 1},
+			{0x1134b, 0x1134c, 1},
+			{0x11357, 0x11357, 1},
+			{0x11362, 0x11363, 1},
+			{0x11435, 0x11441, 1},
+			{0x11443, 0x11445, 1},
+			{0x114b0, 0x114c1, 1},
+			{0x115af, 0x115b5, 1},
+			{0x115b8, 0x115be, 1},
+			{0x115dc, 0x115dd, 1},
+			{0x11630, 0x1163e, 1},
+			{0x11640, 0x11640, 1},
+			{0x116ab, 0x116b5, 1},
+			{0x1171d, 0x1172a, 1},
+			{0x1182c, 0x11838, 1},
+			{0x11930, 0x11935, 1},
+			{0x11937, 0x11938, 1},
+			{0x1193b, 0x1193c, 1},
+			{0x11940, 0x11940, 1},
+			{0x11942, 0x11942, 1},
+			{0x119d1, 0x119d7, 1},
+			{0x119da, 0x119df, 1},
+			{0x119e4, 0x119e4, 1},
+			{0x11a01, 0x11a0a, 1},
+			{0x11a35, 0x11a39, 1},
+			{0x11a3b, 0x11a3e, 1},
+			{0x11a51, 0x11a5b, 1},
+			{0x11a8a, 0x11a97, 1},
+			{0x11c2f, 0x11c36, 1},
+			{0x11c38, 0x11c3e, 1},
+			{0x11c92, 0x11ca7, 1},
+			{0x11ca9, 0x11cb6, 1},
+			{0x11d31, 0x11d36, 1},
+			{0x11d3a, 0x11d3a, 1},
+			{0x11d3c, 0x11d3d, 1},
+			{0x11d3f, 0x11d41, 1},
+			{0x11d43, 0x11d43, 1},
+			{0x11d47, 0x11d47, 1},
+			{0x11d8a, 0x11d8e, 1},
+			{0x11d90, 0x11d91, 1},
+			{0x11d93, 0x11d96, 1},
+			{0x11ef3, 0x11ef6, 1},
+			{0x16f4f, 0x16f4f, 1},
+			{0x16f51, 0x16f87, 1},
+			{0x16f8f, 0x16f92, 1},
+			{0x16ff0, 0x16ff1, 1},
+			{0x1bc9e, 0x1bc9e, 1},
+			{0x1e000, 0x1e006, 1},
+			{0x1e008, 0x1e018, 1},
+			{0x1e01b, 0x1e021, 1},
+			{0x1e023, 0x1e024, 1},
+			{0x1e026, 0x1e02a, 1},
+			{0x1e947, 0x1e947, 1},
+			{0x1f130, 0x1f149, 1},
+			{0x1f150, 0x1f169, 1},
+			{0x1f170, 0x1f189, 1},
+		},
+	},
+	"Other_Default_Ignorable_Code_Point": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x034f, 0x034f, 1},
+			{0x115f, 0x1160, 1},
+			{0x17b4, 0x17b5, 1},
+			{0x2065, 0x2065, 1},
+			{0x3164, 0x3164, 1},
+			{0xffa0, 0xffa0, 1},
+			{0xfff0, 0xfff8, 1},
+		},
+		R32: []unicode.Range32{
+			{0xe0000, 0xe0000, 1},
+			{0xe0002, 0xe001f, 1},
+			{0xe0080, 0xe00ff, 1},
+			{0xe01f0, 0xe0fff, 1},
+		},
+	},
+	"Other_Grapheme_Extend": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x09be, 0x09be, 1},
+			{0x09d7, 0x09d7, 1},
+			{0x0b3e, 0x0b3e, 1},
+			{0x0b57, 0x0b57, 1},
+			{0x0bbe, 0x0bbe, 1},
+			{0x0bd7, 0x0bd7, 1},
+			{0x0cc2, 0x0cc2, 1},
+			{0x0cd5, 0x0cd6, 1},
+			{0x0d3e, 0x0d3e, 1},
+			{0x0d57, 0x0d57, 1},
+			{0x0dcf, 0x0dcf, 1},
+			{0x0ddf, 0x0ddf, 1},
+			{0x1b35, 0x1b35, 1},
+			{0x200c, 0x200c, 1},
+			{0x302e, 0x302f, 1},
+			{0xff9e, 0xff9f, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1133e, 0x1133e, 1},
+			{0x11357, 0x11357, 1},
+			{0x114b0, 0x114b0, 1},
+			{0x114bd, 0x114bd, 1},
+			{0x115af, 0x115af, 1},
+			{0x11930, 0x11930, 1},
+			{0x1d165, 0x1d165, 1},
+			{0x1d16e, 0x1d172, 1},
+			{0xe0020, 0xe007f, 1},
+		},
+	},
+	"Other_ID_Continue": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x00b7, 0x00b7, 1},
+			{0x0387, 0x0387, 1},
+			{0x1369, 0x1371, 1},
+			{0x19da, 0x19da, 1},
+		},
+		LatinOffset: 1,
+	},
+	"Other_ID_Start": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x1885, 0x1886, 1},
+			{0x2118, 0x2118, 1},
+			{0x212e, 0x212e, 1},
+			{0x309b, 0x309c, 1},
+		},
+	},
+	"Other_Lowercase": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x00aa, 0x00aa, 1},
+			{0x00ba, 0x00ba, 1},
+			{0x02b0, 0x02b8, 1},
+			{0x02c0, 0x02c1, 1},
+			{0x02e0, 0x02e4, 1},
+			{0x0345, 0x0345, 1},
+			{0x037a, 0x037a, 1},
+			{0x1d2c, 0x1d6a, 1},
+			{0x1d78, 0x1d78, 1},
+			{0x1d9b, 0x1dbf, 1},
+			{0x2071, 0x2071, 1},
+			{0x207f, 0x207f, 1},
+			{0x2090, 0x209c, 1},
+			{0x2170, 0x217f, 1},
+			{0x24d0, 0x24e9, 1},
+			{0x2c7c, 0x2c7d, 1},
+			{0xa69c, 0xa69d, 1},
+			{0xa770, 0xa770, 1},
+			{0xa7f8, 0xa7f9, 1},
+			{0xab5c, 0xab5f, 1},
+		},
+		R32: []unicode.Range32{
+			{0x10780, 0x10780, 1},
+			{0x10783, 0x10785, 1},
+			{0x10787, 0x107b0, 1},
+			{0x107b2, 0x107ba, 1},
+		},
+		LatinOffset: 2,
+	},
+	"Other_Math": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x005e, 0x005e, 1},
+			{0x03d0, 0x03d2, 1},
+			{0x03d5, 0x03d5, 1},
+			{0x03f0, 0x03f1, 1},
+			{0x03f4, 0x03f5, 1},
+			{0x2016, 0x2016, 1},
+			{0x2032, 0x2034, 1},
+			{0x2040, 0x2040, 1},
+			{0x2061, 0x2064, 1},
+			{0x207d, 0x207e, 1},
+			{0x208d, 0x208e, 1},
+			{0x20d0, 0x20dc, 1},
+			{0x20e1, 0x20e1, 1},
+			{0x20e5, 0x20e6, 1},
+			{0x20eb, 0x20ef, 1},
+			{0x2102, 0x2102, 1},
+			{0x2107, 0x2107, 1},
+			{0x210a, 0x2113, 1},
+			{0x2115, 0x2115, 1},
+			{0x2119, 0x211d, 1},
+			{0x2124, 0x2124, 1},
+			{0x2128, 0x2129, 1},
+			{0x212c, 0x212d, 1},
+			{0x212f, 0x2131, 1},
+			{0x2133, 0x2138, 1},
+			{0x213c, 0x213f, 1},
+			{0x2145, 0x2149, 1},
+			{0x2195, 0x2199, 1},
+			{0x219c, 0x219f, 1},
+			{0x21a1, 0x21a2, 1},
+			{0x21a4, 0x21a5, 1},
+			{0x21a7, 0x21a7, 1},
+			{0x21a9, 0x21ad, 1},
+			{0x21b0, 0x21b1, 1},
+			{0x21b6, 0x21b7, 1},
+			{0x21bc, 0x21cd, 1},
+			{0x21d0, 0x21d1, 1},
+			{0x21d3, 0x21d3, 1},
+			{0x21d5, 0x21db, 1},
+			{0x21dd, 0x21dd, 1},
+			{0x21e4, 0x21e5, 1},
+			{0x2308, 0x230b, 1},
+			{0x23b4, 0x23b5, 1},
+			{0x23b7, 0x23b7, 1},
+			{0x23d0, 0x23d0, 1},
+			{0x23e2, 0x23e2, 1},
+			{0x25a0, 0x25a1, 1},
+			{0x25ae, 0x25b6, 1},
+			{0x25bc, 0x25c0, 1},
+			{0x25c6, 0x25c7, 1},
+			{0x25ca, 0x25cb, 1},
+			{0x25cf, 0x25d3, 1},
+			{0x25e2, 0x25e2, 1},
+			{0x25e4, 0x25e4, 1},
+			{0x25e7, 0x25ec, 1},
+			{0x2605, 0x2606, 1},
+			{0x2640, 0x2640, 1},
+			{0x2642, 0x2642, 1},
+			{0x2660, 0x2663, 1},
+			{0x266d, 0x266e, 1},
+			{0x27c5, 0x27c6, 1},
+			{0x27e6, 0x27ef, 1},
+			{0x2983, 0x2998, 1},
+			{0x29d8, 0x29db, 1},
+			{0x29fc, 0x29fd, 1},
+			{0xfe61, 0xfe61, 1},
+			{0xfe63, 0xfe63, 1},
+			{0xfe68, 0xfe68, 1},
+			{0xff3c, 0xff3c, 1},
+			{0xff3e, 0xff3e, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1d400, 0x1d454, 1},
+			{0x1d456, 0x1d49c, 1},
+			{0x1d49e, 0x1d49f, 1},
+			{0x1d4a2, 0x1d4a2, 1},
+			{0x1d4a5, 0x1d4a6, 1},
+			{0x1d4a9, 0x1d4ac, 1},
+			{0x1d4ae, 0x1d4b9, 1},
+			{0x1d4bb, 0x1d4bb, 1},
+			{0x1d4bd, 0x1d4c3, 1},
+			{0x1d4c5, 0x1d505, 1},
+			{0x1d507, 0x1d50a, 1},
+			{0x1d50d, 0x1d514, 1},
+			{0x1d516, 0x1d51c, 1},
+			{0x1d51e, 0x1d539, 1},
+			{0x1d53b, 0x1d53e, 1},
+			{0x1d540, 0x1d544, 1},
+			{0x1d546, 0x1d546, 1},
+			{0x1d54a, 0x1d550, 1},
+			{0x1d552, 0x1d6a5, 1},
+			{0x1d6a8, 0x1d6c0, 1},
+			{0x1d6c2, 0x1d6da, 1},
+			{0x1d6dc, 0x1d6fa, 1},
+			{0x1d6fc, 0x1d714, 1},
+			{0x1d716, 0x1d734, 1},
+			{0x1d736, 0x1d74e, 1},
+			{0x1d750, 0x1d76e, 1},
+			{0x1d770, 0x1d788, 1},
+			{0x1d78a, 0x1d7a8, 1},
+			{0x1d7aa, 0x1d7c2, 1},
+			{0x1d7c4, 0x1d7cb, 1},
+			{0x1d7ce, 0x1d7ff, 1},
+			{0x1ee00, 0x1ee03, 1},
+			{0x1ee05, 0x1ee1f, 1},
+			{0x1ee21, 0x1ee22, 1},
+			{0x1ee24, 0x1ee24, 1},
+			{0x1ee27, 0x1ee27, 1},
+			{0x1ee29, 0x1ee32, 1},
+			{0x1ee34, 0x1ee37, 1},
+			{0x1ee39, 0x1ee39, 1},
+			{0x1ee3b, 0x1ee3b, 1},
+			{0x1ee42, 0x1ee42, 1},
+			{0x1ee47, 0x1ee47, 1},
+			{0x1ee49, 0x1ee49, 1},
+			{0x1ee4b, 0x1ee4b, 1},
+			{0x1ee4d, 0x1ee4f, 1},
+			{0x1ee51, 0x1ee52, 1},
+			{0x1ee54, 0x1ee54, 1},
+			{0x1ee57, 0x1ee57, 1},
+			{0x1ee59, 0x1ee59, 1},
+			{0x1ee5b, 0x1ee5b, 1},
+			{0x1ee5d, 0x1ee5d, 1},
+			{0x1ee5f, 0x1ee5f, 1},
+			{0x1ee61, 0x1ee62, 1},
+			{0x1ee64, 0x1ee64, 1},
+			{0x1ee67, 0x1ee6a, 1},
+			{0x1ee6c, 0x1ee72, 1},
+			{0x1ee74, 0x1ee77, 1},
+			{0x1ee79, 0x1ee7c, 1},
+			{0x1ee7e, 0x1ee7e, 1},
+			{0x1ee80, 0x1ee89, 1},
+			{0x1ee8b, 0x1ee9b, 1},
+			{0x1eea1, 0x1eea3, 1},
+			{0x1eea5, 0x1eea9, 1},
+			{0x1eeab, 0x1eebb, 1},
+		},
+		LatinOffset: 1,
+	},
+	"Other_Uppercase": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x2160, 0x216f, 1},
+			{0x24b6, 0x24cf, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1f130, 0x1f149, 1},
+			{0x1f150, 0x1f169, 1},
+			{0x1f170, 0x1f189, 1},
+		},
+	},
+	"Pattern_Syntax": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0021, 0x002f, 1},
+			{0x003a, 0x0040, 1},
+			{0x005b, 0x005e, 1},
+			{0x0060, 0x0060, 1},
+			{0x007b, 0x007e, 1},
+			{0x00a1, 0x00a7, 1},
+			{0x00a9, 0x00a9, 1},
+			{0x00ab, 0x00ac, 1},
+			{0x00ae, 0x00ae, 1},
+			{0x00b0, 0x00b1, 1},
+			{0x00b6, 0x00b6, 1},
+			{0x00bb, 0x00bb, 1},
+			{0x00bf, 0x00bf, 1},
+			{0x00d7, 0x00d7, 1},
+			{0x00f7, 0x00f7, 1},
+			{0x2010, 0x2027, 1},
+			{0x2030, 0x203e, 1},
+			{0x2041, 0x2053, 1},
+			{0x2055, 0x205e, 1},
+			{0x2190, 0x245f, 1},
+			{0x2500, 0x2775, 1},
+			{0x2794, 0x2bff, 1},
+			{0x2e00, 0x2e7f, 1},
+			{0x3001, 0x3003, 1},
+			{0x3008, 0x3020, 1},
+			{0x3030, 0x3030, 1},
+			{0xfd3e, 0xfd3f, 1},
+			{0xfe45, 0xfe46, 1},
+		},
+		LatinOffset: 15,
+	},
+	"Pattern_White_Space": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0009, 0x000d, 1},
+			{0x0020, 0x0020, 1},
+			{0x0085, 0x0085, 1},
+			{0x200e, 0x200f, 1},
+			{0x2028, 0x2029, 1},
+		},
+		LatinOffset: 3,
+	},
+	"Prepended_Concatenation_Mark": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0600, 0x0605, 1},
+			{0x06dd, 0x06dd, 1},
+			{0x070f, 0x070f, 1},
+			{0x0890, 0x0891, 1},
+			{0x08e2, 0x08e2, 1},
+		},
+		R32: []unicode.Range32{
+			{0x110bd, 0x110bd, 1},
+			{0x110cd, 0x110cd, 1},
+		},
+	},
+	"Quotation_Mark": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0022, 0x0022, 1},
+			{0x0027, 0x0027, 1},
+			{0x00ab, 0x00ab, 1},
+			{0x00bb, 0x00bb, 1},
+			{0x2018, 0x201f, 1},
+			{0x2039, 0x203a, 1},
+			{0x2e42, 0x2e42, 1},
+			{0x300c, 0x300f, 1},
+			{0x301d, 0x301f, 1},
+			{0xfe41, 0xfe44, 1},
+			{0xff02, 0xff02, 1},
+			{0xff07, 0xff07, 1},
+			{0xff62, 0xff63, 1},
+		},
+		LatinOffset: 4,
+	},
+	"Radical": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x2e80, 0x2e99, 1},
+			{0x2e9b, 0x2ef3, 1},
+			{0x2f00, 0x2fd5, 1},
+		},
+	},
+	"Regional_Indicator": &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{0x1f1e6, 0x1f1ff, 1},
+		},
+	},
+	"Sentence_Terminal": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0021, 0x0021, 1},
+			{0x002e, 0x002e, 1},
+			{0x003f, 0x003f, 1},
+			{0x0589, 0x0589, 1},
+			{0x061d, 0x061f, 1},
+			{0x06d4, 0x06d4, 1},
+			{0x0700, 0x0702, 1},
+			{0x07f9, 0x07f9, 1},
+			{0x0837, 0x0837, 1},
+			{0x0839, 0x0839, 1},
+			{0x083d, 0x083e, 1},
+			{0x0964, 0x0965, 1},
+			{0x104a, 0x104b, 1},
+			{0x1362, 0x1362, 1},
+			{0x1367, 0x1368, 1},
+			{0x166e, 0x166e, 1},
+			{0x1735, 0x1736, 1},
+			{0x1803, 0x1803, 1},
+			{0x1809, 0x1809, 1},
+			{0x1944, 0x1945, 1},
+			{0x1aa8, 0x1aab, 1},
+			{0x1b5a, 0x1b5b, 1},
+			{0x1b5e, 0x1b5f, 1},
+			{0x1b7d, 0x1b7e, 1},
+			{0x1c3b, 0x1c3c, 1},
+			{0x1c7e, 0x1c7f, 1},
+			{0x203c, 0x203d, 1},
+			{0x2047, 0x2049, 1},
+			{0x2e2e, 0x2e2e, 1},
+			{0x2e3c, 0x2e3c, 1},
+			{0x2e53, 0x2e54, 1},
+			{0x3002, 0x3002, 1},
+			{0xa4ff, 0xa4ff, 1},
+			{0xa60e, 0xa60f, 1},
+			{0xa6f3, 0xa6f3, 1},
+			{0xa6f7, 0xa6f7, 1},
+			{0xa876, 0xa877, 1},
+			{0xa8ce, 0xa8cf, 1},
+			{0xa92f, 0xa92f, 1},
+			{0xa9c8, 0xa9c9, 1},
+			{0xaa5d, 0xaa5f, 1},
+			{0xaaf0, 0xaaf1, 1},
+			{0xabeb, 0xabeb, 1},
+			{0xfe52, 0xfe52, 1},
+			{0xfe56, 0xfe57, 1},
+			{0xff01, 0xff01, 1},
+			{0xff0e, 0xff0e, 1},
+			{0xff1f, 0xff1f, 1},
+			{0xff61, 0xff61, 1},
+		},
+		R32: []unicode.Range32{
+			{0x10a56, 0x10a57, 1},
+			{0x10f55, 0x10f59, 1},
+			{0x10f86, 0x10f89, 1},
+			{0x11047, 0x11048, 1},
+			{0x110be, 0x110c1, 1},
+			{0x11141, 0x11143, 1},
+			{0x111c5, 0x111c6, 1},
+			{0x111cd, 0x111cd, 1},
+			{0x111de, 0x111df, 1},
+			{0x11238, 0x11239, 1},
+			{0x1123b, 0x1123c, 1},
+			{0x112a9, 0x112a9, 1},
+			{0x1144b, 0x1144c, 1},
+			{0x115c2, 0x115c3, 1},
+			{0x115c9, 0x115d7, 1},
+			{0x11641, 0x11642, 1},
+			{0x1173c, 0x1173e, 1},
+			{0x11944, 0x11944, 1},
+			{0x11946, 0x11946, 1},
+			{0x11a42, 0x11a43, 1},
+			{0x11a9b, 0x11a9c, 1},
+			{0x11c41, 0x11c42, 1},
+			{0x11ef7, 0x11ef8, 1},
+			{0x16a6e, 0x16a6f, 1},
+			{0x16af5, 0x16af5, 1},
+			{0x16b37, 0x16b38, 1},
+			{0x16b44, 0x16b44, 1},
+			{0x16e98, 0x16e98, 1},
+			{0x1bc9f, 0x1bc9f, 1},
+			{0x1da88, 0x1da88, 1},
+		},
+		LatinOffset: 3,
+	},
+	"Soft_Dotted": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0069, 0x006a, 1},
+			{0x012f, 0x012f, 1},
+			{0x0249, 0x0249, 1},
+			{0x0268, 0x0268, 1},
+			{0x029d, 0x029d, 1},
+			{0x02b2, 0x02b2, 1},
+			{0x03f3, 0x03f3, 1},
+			{0x0456, 0x0456, 1},
+			{0x0458, 0x0458, 1},
+			{0x1d62, 0x1d62, 1},
+			{0x1d96, 0x1d96, 1},
+			{0x1da4, 0x1da4, 1},
+			{0x1da8, 0x1da8, 1},
+			{0x1e2d, 0x1e2d, 1},
+			{0x1ecb, 0x1ecb, 1},
+			{0x2071, 0x2071, 1},
+			{0x2148, 0x2149, 1},
+			{0x2c7c, 0x2c7c, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1d422, 0x1d423, 1},
+			{0x1d456, 0x1d457, 1},
+			{0x1d48a, 0x1d48b, 1},
+			{0x1d4be, 0x1d4bf, 1},
+			{0x1d4f2, 0x1d4f3, 1},
+			{0x1d526, 0x1d527, 1},
+			{0x1d55a, 0x1d55b, 1},
+			{0x1d58e, 0x1d58f, 1},
+			{0x1d5c2, 0x1d5c3, 1},
+			{0x1d5f6, 0x1d5f7, 1},
+			{0x1d62a, 0x1d62b, 1},
+			{0x1d65e, 0x1d65f, 1},
+			{0x1d692, 0x1d693, 1},
+			{0x1df1a, 0x1df1a, 1},
+		},
+		LatinOffset: 1,
+	},
+	"Terminal_Punctuation": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0021, 0x0021, 1},
+			{0x002c, 0x002c, 1},
+			{0x002e, 0x002e, 1},
+			{0x003a, 0x003b, 1},
+			{0x003f, 0x003f, 1},
+			{0x037e, 0x037e, 1},
+			{0x0387, 0x0387, 1},
+			{0x0589, 0x0589, 1},
+			{0x05c3, 0x05c3, 1},
+			{0x060c, 0x060c, 1},
+			{0x061b, 0x061b, 1},
+			{0x061d, 0x061f, 1},
+			{0x06d4, 0x06d4, 1},
+			{0x0700, 0x070a, 1},
+			{0x070c, 0x070c, 1},
+			{0x07f8, 0x07f9, 1},
+			{0x0830, 0x083e, 1},
+			{0x085e, 0x085e, 1},
+			{0x0964, 0x0965, 1},
+			{0x0e5a, 0x0e5b, 1},
+			{0x0f08, 0x0f08, 1},
+			{0x0f0d, 0x0f12, 1},
+			{0x104a, 0x104b, 1},
+			{0x1361, 0x1368, 1},
+			{0x166e, 0x166e, 1},
+			{0x16eb, 0x16ed, 1},
+			{0x1735, 0x1736, 1},
+			{0x17d4, 0x17d6, 1},
+			{0x17da, 0x17da, 1},
+			{0x1802, 0x1805, 1},
+			{0x1808, 0x1809, 1},
+			{0x1944, 0x1945, 1},
+			{0x1aa8, 0x1aab, 1},
+			{0x1b5a, 0x1b5b, 1},
+			{0x1b5d, 0x1b5f, 1},
+			{0x1b7d, 0x1b7e, 1},
+			{0x1c3b, 0x1c3f, 1},
+			{0x1c7e, 0x1c7f, 1},
+			{0x203c, 0x203d, 1},
+			{0x2047, 0x2049, 1},
+			{0x2e2e, 0x2e2e, 1},
+			{0x2e3c, 0x2e3c, 1},
+			{0x2e41, 0x2e41, 1},
+			{0x2e4c, 0x2e4c, 1},
+			{0x2e4e, 0x2e4f, 1},
+			{0x2e53, 0x2e54, 1},
+			{0x3001, 0x3002, 1},
+			{0xa4fe, 0xa4ff, 1},
+			{0xa60d, 0xa60f, 1},
+			{0xa6f3, 0xa6f7, 1},
+			{0xa876, 0xa877, 1},
+			{0xa8ce, 0xa8cf, 1},
+			{0xa92f, 0xa92f, 1},
+			{0xa9c7, 0xa9c9, 1},
+			{0xaa5d, 0xaa5f, 1},
+			{0xaadf, 0xaadf, 1},
+			{0xaaf0, 0xaaf1, 1},
+			{0xabeb, 0xabeb, 1},
+			{0xfe50, 0xfe52, 1},
+			{0xfe54, 0xfe57, 1},
+			{0xff01, 0xff01, 1},
+			{0xff0c, 0xff0c, 1},
+			{0xff0e, 0xff0e, 1},
+			{0xff1a, 0xff1b, 1},
+			{0xff1f, 0xff1f, 1},
+			{0xff61, 0xff61, 1},
+			{0xff64, 0xff64, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1039f, 0x1039f, 1},
+			{0x103d0, 0x103d0, 1},
+			{0x10857, 0x10857, 1},
+			{0x1091f, 0x1091f, 1},
+			{0x10a56, 0x10a57, 1},
+			{0x10af0, 0x10af5, 1},
+			{0x10b3a, 0x10b3f, 1},
+			{0x10b99, 0x10b9c, 1},
+			{0x10f55, 0x10f59, 1},
+			{0x10f86, 0x10f89, 1},
+			{0x11047, 0x1104d, 1},
+			{0x110be, 0x110c1, 1},
+			{0x11141, 0x11143, 1},
+			{0x111c5, 0x111c6, 1},
+			{0x111cd, 0x111cd, 1},
+			{0x111de, 0x111df, 1},
+			{0x11238, 0x1123c, 1},
+			{0x112a9, 0x112a9, 1},
+			{0x1144b, 0x1144d, 1},
+			{0x1145a, 0x1145b, 1},
+			{0x115c2, 0x115c5, 1},
+			{0x115c9, 0x115d7, 1},
+			{0x11641, 0x11642, 1},
+			{0x1173c, 0x1173e, 1},
+			{0x11944, 0x11944, 1},
+			{0x11946, 0x11946, 1},
+			{0x11a42, 0x11a43, 1},
+			{0x11a9b, 0x11a9c, 1},
+			{0x11aa1, 0x11aa2, 1},
+			{0x11c41, 0x11c43, 1},
+			{0x11c71, 0x11c71, 1},
+			{0x11ef7, 0x11ef8, 1},
+			{0x12470, 0x12474, 1},
+			{0x16a6e, 0x16a6f, 1},
+			{0x16af5, 0x16af5, 1},
+			{0x16b37, 0x16b39, 1},
+			{0x16b44, 0x16b44, 1},
+			{0x16e97, 0x16e98, 1},
+			{0x1bc9f, 0x1bc9f, 1},
+			{0x1da87, 0x1da8a, 1},
+		},
+		LatinOffset: 5,
+	},
+	"Unified_Ideograph": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x3400, 0x4dbf, 1},
+			{0x4e00, 0x9fff, 1},
+			{0xfa0e, 0xfa0f, 1},
+			{0xfa11, 0xfa11, 1},
+			{0xfa13, 0xfa14, 1},
+			{0xfa1f, 0xfa1f, 1},
+			{0xfa21, 0xfa21, 1},
+			{0xfa23, 0xfa24, 1},
+			{0xfa27, 0xfa29, 1},
+		},
+		R32: []unicode.Range32{
+			{0x20000, 0x2a6df, 1},
+			{0x2a700, 0x2b738, 1},
+			{0x2b740, 0x2b81d, 1},
+			{0x2b820, 0x2cea1, 1},
+			{0x2ceb0, 0x2ebe0, 1},
+			{0x30000, 0x3134a, 1},
+		},
+	},
+	"Variation_Selector": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x180b, 0x180d, 1},
+			{0x180f, 0x180f, 1},
+			{0xfe00, 0xfe0f, 1},
+		},
+		R32: []unicode.Range32{
+			{0xe0100, 0xe01ef, 1},
+		},
+	},
+	"White_Space": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0009, 0x000d, 1},
+			{0x0020, 0x0020, 1},
+			{0x0085, 0x0085, 1},
+			{0x00a0, 0x00a0, 1},
+			{0x1680, 0x1680, 1},
+			{0x2000, 0x200a, 1},
+			{0x2028, 0x2029, 1},
+			{0x202f, 0x202f, 1},
+			{0x205f, 0x205f, 1},
+			{0x3000, 0x3000, 1},
+		},
+		LatinOffset: 4,
+	},
+}
+
+// emojiProperties are the emoji properties of characters.
+var emojiProperties = map[string]*unicode.RangeTable{
+	"Emoji": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0023, 0x0023, 1},
+			{0x002a, 0x002a, 1},
+			{0x0030, 0x0039, 1},
+			{0x00a9, 0x00a9, 1},
+			{0x00ae, 0x00ae, 1},
+			{0x203c, 0x203c, 1},
+			{0x2049, 0x2049, 1},
+			{0x2122, 0x2122, 1},
+			{0x2139, 0x2139, 1},
+			{0x2194, 0x2199, 1},
+			{0x21a9, 0x21aa, 1},
+			{0x231a, 0x231b, 1},
+			{0x2328, 0x2328, 1},
+			{0x23cf, 0x23cf, 1},
+			{0x23e9, 0x23f3, 1},
+			{0x23f8, 0x23fa, 1},
+			{0x24c2, 0x24c2, 1},
+			{0x25aa, 0x25ab, 1},
+			{0x25b6, 0x25b6, 1},
+			{0x25c0, 0x25c0, 1},
+			{0x25fb, 0x25fe, 1},
+			{0x2600, 0x2604, 1},
+			{0x260e, 0x260e, 1},
+			{0x2611, 0x2611, 1},
+			{0x2614, 0x2615, 1},
+			{0x2618, 0x2618, 1},
+			{0x261d, 0x261d, 1},
+			{0x2620, 0x2620, 1},
+			{0x2622, 0x2623, 1},
+			{0x2626, 0x2626, 1},
+			{0x262a, 0x262a, 1},
+			{0x262e, 0x262f, 1},
+			{0x2638, 0x263a, 1},
+			{0x2640, 0x2640, 1},
+			{0x2642, 0x2642, 1},
+			{0x2648, 0x2653, 1},
+			{0x265f, 0x2660, 1},
+			{0x2663, 0x2663, 1},
+			{0x2665, 0x2666, 1},
+			{0x2668, 0x2668, 1},
+			{0x267b, 0x267b, 1},
+			{0x267e, 0x267f, 1},
+			{0x2692, 0x2697, 1},
+			{0x2699, 0x2699, 1},
+			{0x269b, 0x269c, 1},
+			{0x26a0, 0x26a1, 1},
+			{0x26a7, 0x26a7, 1},
+			{0x26aa, 0x26ab, 1},
+			{0x26b0, 0x26b1, 1},
+			{0x26bd, 0x26be, 1},
+			{0x26c4, 0x26c5, 1},
+			{0x26c8, 0x26c8, 1},
+			{0x26ce, 0x26cf, 1},
+			{0x26d1, 0x26d1, 1},
+			{0x26d3, 0x26d4, 1},
+			{0x26e9, 0x26ea, 1},
+			{0x26f0, 0x26f5, 1},
+			{0x26f7, 0x26fa, 1},
+			{0x26fd, 0x26fd, 1},
+			{0x2702, 0x2702, 1},
+			{0x2705, 0x2705, 1},
+			{0x2708, 0x270d, 1},
+			{0x270f, 0x270f, 1},
+			{0x2712, 0x2712, 1},
+			{0x2714, 0x2714, 1},
+			{0x2716, 0x2716, 1},
+			{0x271d, 0x271d, 1},
+			{0x2721, 0x2721, 1},
+			{0x2728, 0x2728, 1},
+			{0x2733, 0x2734, 1},
+			{0x2744, 0x2744, 1},
+			{0x2747, 0x2747, 1},
+			{0x274c, 0x274c, 1},
+			{0x274e, 0x274e, 1},
+			{0x2753, 0x2755, 1},
+			{0x2757, 0x2757, 1},
+			{0x2763, 0x2764, 1},
+			{0x2795, 0x2797, 1},
+			{0x27a1, 0x27a1, 1},
+			{0x27b0, 0x27b0, 1},
+			{0x27bf, 0x27bf, 1},
+			{0x2934, 0x2935, 1},
+			{0x2b05, 0x2b07, 1},
+			{0x2b1b, 0x2b1c, 1},
+			{0x2b50, 0x2b50, 1},
+			{0x2b55, 0x2b55, 1},
+			{0x3030, 0x3030, 1},
+			{0x303d, 0x303d, 1},
+			{0x3297, 0x3297, 1},
+			{0x3299, 0x3299, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1f004, 0x1f004, 1},
+			{0x1f0cf, 0x1f0cf, 1},
+			{0x1f170, 0x1f171, 1},
+			{0x1f17e, 0x1f17f, 1},
+			{0x1f18e, 0x1f18e, 1},
+			{0x1f191, 0x1f19a, 1},
+			{0x1f1e6, 0x1f1ff, 1},
+			{0x1f201, 0x1f202, 1},
+			{0x1f21a, 0x1f21a, 1},
+			{0x1f22f, 0x1f22f, 1},
+			{0x1f232, 0x1f23a, 1},
+			{0x1f250, 0x1f251, 1},
+			{0x1f300, 0x1f321, 1},
+			{0x1f324, 0x1f393, 1},
+			{0x1f396, 0x1f397, 1},
+			{0x1f399, 0x1f39b, 1},
+			{0x1f39e, 0x1f3f0, 1},
+			{0x1f3f3, 0x1f3f5, 1},
+			{0x1f3f7, 0x1f4fd, 1},
+			{0x1f4ff, 0x1f53d, 1},
+			{0x1f549, 0x1f54e, 1},
+			{0x1f550, 0x1f567, 1},
+			{0x1f56f, 0x1f570, 1},
+			{0x1f573, 0x1f57a, 1},
+			{0x1f587, 0x1f587, 1},
+			{0x1f58a, 0x1f58d, 1},
+			{0x1f590, 0x1f590, 1},
+			{0x1f595, 0x1f596, 1},
+			{0x1f5a4, 0x1f5a5, 1},
+			{0x1f5a8, 0x1f5a8, 1},
+			{0x1f5b1, 0x1f5b2, 1},
+			{0x1f5bc, 0x1f5bc, 1},
+			{0x1f5c2, 0x1f5c4, 1},
+			{0x1f5d1, 0x1f5d3, 1},
+			{0x1f5dc, 0x1f5de, 1},
+			{0x1f5e1, 0x1f5e1, 1},
+			{0x1f5e3, 0x1f5e3, 1},
+			{0x1f5e8, 0x1f5e8, 1},
+			{0x1f5ef, 0x1f5ef, 1},
+			{0x1f5f3, 0x1f5f3, 1},
+			{0x1f5fa, 0x1f64f, 1},
+			{0x1f680, 0x1f6c5, 1},
+			{0x1f6cb, 0x1f6d2, 1},
+			{0x1f6d5, 0x1f6d7, 1},
+			{0x1f6dd, 0x1f6e5, 1},
+			{0x1f6e9, 0x1f6e9, 1},
+			{0x1f6eb, 0x1f6ec, 1},
+			{0x1f6f0, 0x1f6f0, 1},
+			{0x1f6f3, 0x1f6fc, 1},
+			{0x1f7e0, 0x1f7eb, 1},
+			{0x1f7f0, 0x1f7f0, 1},
+			{0x1f90c, 0x1f93a, 1},
+			{0x1f93c, 0x1f945, 1},
+			{0x1f947, 0x1f9ff, 1},
+			{0x1fa70, 0x1fa74, 1},
+			{0x1fa78, 0x1fa7c, 1},
+			{0x1fa80, 0x1fa86, 1},
+			{0x1fa90, 0x1faac, 1},
+			{0x1fab0, 0x1faba, 1},
+			{0x1fac0, 0x1fac5, 1},
+			{0x1fad0, 0x1fad9, 1},
+			{0x1fae0, 0x1fae7, 1},
+			{0x1faf0, 0x1faf6, 1},
+		},
+		LatinOffset: 5,
+	},
+	"Emoji_Component": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x0023, 0x0023, 1},
+			{0x002a, 0x002a, 1},
+			{0x0030, 0x0039, 1},
+			{0x200d, 0x200d, 1},
+			{0x20e3, 0x20e3, 1},
+			{0xfe0f, 0xfe0f, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1f1e6, 0x1f1ff, 1},
+			{0x1f3fb, 0x1f3ff, 1},
+			{0x1f9b0, 0x1f9b3, 1},
+			{0xe0020, 0xe007f, 1},
+		},
+		LatinOffset: 3,
+	},
+	"Emoji_Modifier": &unicode.RangeTable{
+		R32: []unicode.Range32{
+			{0x1f3fb, 0x1f3ff, 1},
+		},
+	},
+	"Emoji_Modifier_Base": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x261d, 0x261d, 1},
+			{0x26f9, 0x26f9, 1},
+			{0x270a, 0x270d, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1f385, 0x1f385, 1},
+			{0x1f3c2, 0x1f3c4, 1},
+			{0x1f3c7, 0x1f3c7, 1},
+			{0x1f3ca, 0x1f3cc, 1},
+			{0x1f442, 0x1f443, 1},
+			{0x1f446, 0x1f450, 1},
+			{0x1f466, 0x1f478, 1},
+			{0x1f47c, 0x1f47c, 1},
+			{0x1f481, 0x1f483, 1},
+			{0x1f485, 0x1f487, 1},
+			{0x1f48f, 0x1f48f, 1},
+			{0x1f491, 0x1f491, 1},
+			{0x1f4aa, 0x1f4aa, 1},
+			{0x1f574, 0x1f575, 1},
+			{0x1f57a, 0x1f57a, 1},
+			{0x1f590, 0x1f590, 1},
+			{0x1f595, 0x1f596, 1},
+			{0x1f645, 0x1f647, 1},
+			{0x1f64b, 0x1f64f, 1},
+			{0x1f6a3, 0x1f6a3, 1},
+			{0x1f6b4, 0x1f6b6, 1},
+			{0x1f6c0, 0x1f6c0, 1},
+			{0x1f6cc, 0x1f6cc, 1},
+			{0x1f90c, 0x1f90c, 1},
+			{0x1f90f, 0x1f90f, 1},
+			{0x1f918, 0x1f91f, 1},
+			{0x1f926, 0x1f926, 1},
+			{0x1f930, 0x1f939, 1},
+			{0x1f93c, 0x1f93e, 1},
+			{0x1f977, 0x1f977, 1},
+			{0x1f9b5, 0x1f9b6, 1},
+			{0x1f9b8, 0x1f9b9, 1},
+			{0x1f9bb, 0x1f9bb, 1},
+			{0x1f9cd, 0x1f9cf, 1},
+			{0x1f9d1, 0x1f9dd, 1},
+			{0x1fac3, 0x1fac5, 1},
+			{0x1faf0, 0x1faf6, 1},
+		},
+	},
+	"Emoji_Presentation": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x231a, 0x231b, 1},
+			{0x23e9, 0x23ec, 1},
+			{0x23f0, 0x23f0, 1},
+			{0x23f3, 0x23f3, 1},
+			{0x25fd, 0x25fe, 1},
+			{0x2614, 0x2615, 1},
+			{0x2648, 0x2653, 1},
+			{0x267f, 0x267f, 1},
+			{0x2693, 0x2693, 1},
+			{0x26a1, 0x26a1, 1},
+			{0x26aa, 0x26ab, 1},
+			{0x26bd, 0x26be, 1},
+			{0x26c4, 0x26c5, 1},
+			{0x26ce, 0x26ce, 1},
+			{0x26d4, 0x26d4, 1},
+			{0x26ea, 0x26ea, 1},
+			{0x26f2, 0x26f3, 1},
+			{0x26f5, 0x26f5, 1},
+			{0x26fa, 0x26fa, 1},
+			{0x26fd, 0x26fd, 1},
+			{0x2705, 0x2705, 1},
+			{0x270a, 0x270b, 1},
+			{0x2728, 0x2728, 1},
+			{0x274c, 0x274c, 1},
+			{0x274e, 0x274e, 1},
+			{0x2753, 0x2755, 1},
+			{0x2757, 0x2757, 1},
+			{0x2795, 0x2797, 1},
+			{0x27b0, 0x27b0, 1},
+			{0x27bf, 0x27bf, 1},
+			{0x2b1b, 0x2b1c, 1},
+			{0x2b50, 0x2b50, 1},
+			{0x2b55, 0x2b55, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1f004, 0x1f004, 1},
+			{0x1f0cf, 0x1f0cf, 1},
+			{0x1f18e, 0x1f18e, 1},
+			{0x1f191, 0x1f19a, 1},
+			{0x1f1e6, 0x1f1ff, 1},
+			{0x1f201, 0x1f201, 1},
+			{0x1f21a, 0x1f21a, 1},
+			{0x1f22f, 0x1f22f, 1},
+			{0x1f232, 0x1f236, 1},
+			{0x1f238, 0x1f23a, 1},
+			{0x1f250, 0x1f251, 1},
+			{0x1f300, 0x1f320, 1},
+			{0x1f32d, 0x1f335, 1},
+			{0x1f337, 0x1f37c, 1},
+			{0x1f37e, 0x1f393, 1},
+			{0x1f3a0, 0x1f3ca, 1},
+			{0x1f3cf, 0x1f3d3, 1},
+			{0x1f3e0, 0x1f3f0, 1},
+			{0x1f3f4, 0x1f3f4, 1},
+			{0x1f3f8, 0x1f43e, 1},
+			{0x1f440, 0x1f440, 1},
+			{0x1f442, 0x1f4fc, 1},
+			{0x1f4ff, 0x1f53d, 1},
+			{0x1f54b, 0x1f54e, 1},
+			{0x1f550, 0x1f567, 1},
+			{0x1f57a, 0x1f57a, 1},
+			{0x1f595, 0x1f596, 1},
+			{0x1f5a4, 0x1f5a4, 1},
+			{0x1f5fb, 0x1f64f, 1},
+			{0x1f680, 0x1f6c5, 1},
+			{0x1f6cc, 0x1f6cc, 1},
+			{0x1f6d0, 0x1f6d2, 1},
+			{0x1f6d5, 0x1f6d7, 1},
+			{0x1f6dd, 0x1f6df, 1},
+			{0x1f6eb, 0x1f6ec, 1},
+			{0x1f6f4, 0x1f6fc, 1},
+			{0x1f7e0, 0x1f7eb, 1},
+			{0x1f7f0, 0x1f7f0, 1},
+			{0x1f90c, 0x1f93a, 1},
+			{0x1f93c, 0x1f945, 1},
+			{0x1f947, 0x1f9ff, 1},
+			{0x1fa70, 0x1fa74, 1},
+			{0x1fa78, 0x1fa7c, 1},
+			{0x1fa80, 0x1fa86, 1},
+			{0x1fa90, 0x1faac, 1},
+			{0x1fab0, 0x1faba, 1},
+			{0x1fac0, 0x1fac5, 1},
+			{0x1fad0, 0x1fad9, 1},
+			{0x1fae0, 0x1fae7, 1},
+			{0x1faf0, 0x1faf6, 1},
+		},
+	},
+	"Extended_Pictographic": &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{0x00a9, 0x00a9, 1},
+			{0x00ae, 0x00ae, 1},
+			{0x203c, 0x203c, 1},
+			{0x2049, 0x2049, 1},
+			{0x2122, 0x2122, 1},
+			{0x2139, 0x2139, 1},
+			{0x2194, 0x2199, 1},
+			{0x21a9, 0x21aa, 1},
+			{0x231a, 0x231b, 1},
+			{0x2328, 0x2328, 1},
+			{0x2388, 0x2388, 1},
+			{0x23cf, 0x23cf, 1},
+			{0x23e9, 0x23f3, 1},
+			{0x23f8, 0x23fa, 1},
+			{0x24c2, 0x24c2, 1},
+			{0x25aa, 0x25ab, 1},
+			{0x25b6, 0x25b6, 1},
+			{0x25c0, 0x25c0, 1},
+			{0x25fb, 0x25fe, 1},
+			{0x2600, 0x2605, 1},
+			{0x2607, 0x2612, 1},
+			{0x2614, 0x2685, 1},
+			{0x2690, 0x2705, 1},
+			{0x2708, 0x2712, 1},
+			{0x2714, 0x2714, 1},
+			{0x2716, 0x2716, 1},
+			{0x271d, 0x271d, 1},
+			{0x2721, 0x2721, 1},
+			{0x2728, 0x2728, 1},
+			{0x2733, 0x2734, 1},
+			{0x2744, 0x2744, 1},
+			{0x2747, 0x2747, 1},
+			{0x274c, 0x274c, 1},
+			{0x274e, 0x274e, 1},
+			{0x2753, 0x2755, 1},
+			{0x2757, 0x2757, 1},
+			{0x2763, 0x2767, 1},
+			{0x2795, 0x2797, 1},
+			{0x27a1, 0x27a1, 1},
+			{0x27b0, 0x27b0, 1},
+			{0x27bf, 0x27bf, 1},
+			{0x2934, 0x2935, 1},
+			{0x2b05, 0x2b07, 1},
+			{0x2b1b, 0x2b1c, 1},
+			{0x2b50, 0x2b50, 1},
+			{0x2b55, 0x2b55, 1},
+			{0x3030, 0x3030, 1},
+			{0x303d, 0x303d, 1},
+			{0x3297, 0x3297, 1},
+			{0x3299, 0x3299, 1},
+		},
+		R32: []unicode.Range32{
+			{0x1f000, 0x1f0ff, 1},
+			{0x1f10d, 0x1f10f, 1},
+			{0x1f12f, 0x1f12f, 1},
+			{0x1f16c, 0x1f171, 1},
+			{0x1f17e, 0x1f17f, 1},
+			{0x1f18e, 0x1f18e, 1},
+			{0x1f191, 0x1f19a, 1},
+			{0x1f1ad, 0x1f1e5, 1},
+			{0x1f201, 0x1f20f, 1},
+			{0x1f21a, 0x1f21a, 1},
+			{0x1f22f, 0x1f22f, 1},
+			{0x1f232, 0x1f23a, 1},
+			{0x1f23c, 0x1f23f, 1},
+			{0x1f249, 0x1f3fa, 1},
+			{0x1f400, 0x1f53d, 1},
+			{0x1f546, 0x1f64f, 1},
+			{0x1f680, 0x1f6ff, 1},
+			{0x1f774, 0x1f77f, 1},
+			{0x1f7d5, 0x1f7ff, 1},
+			{0x1f80c, 0x1f80f, 1},
+			{0x1f848, 0x1f84f, 1},
+			{0x1f85a, 0x1f85f, 1},
+			{0x1f888, 0x1f88f, 1},
+			{0x1f8ae, 0x1f8ff, 1},
+			{0x1f90c, 0x1f93a, 1},
+			{0x1f93c, 0x1f945, 1},
+			{0x1f947, 0x1faff, 1},
+			{0x1fc00, 0x1fffd, 1},
+		},
+		LatinOffset: 2,
+	},
+}
+
+// aliases are the formal aliases of characters, as NameAliases.txt lists them.
+var aliases = []alias{
+	{0x0000, "NULL", "control"},
+	{0x0000, "NUL", "abbreviation"},
+	{0x0001, "START OF HEADING", "control"},
+	{0x0001, "SOH", "abbreviation"},
+	{0x0002, "START OF TEXT", "control"},
+	{0x0002, "STX", "abbreviation"},
+	{0x0003, "END OF TEXT", "control"},
+	{0x0003, "ETX", "abbreviation"},
+	{0x0004, "END OF TRANSMISSION", "control"},
+	{0x0004, "EOT", "abbreviation"},
+	{0x0005, "ENQUIRY", "control"},
+	{0x0005, "ENQ", "abbreviation"},
+	{0x0006, "ACKNOWLEDGE", "control"},
+	{0x0006, "ACK", "abbreviation"},
+	{0x0007, "ALERT", "control"},
+	{0x0007, "BEL", "abbreviation"},
+	{0x0008, "BACKSPACE", "control"},
+	{0x0008, "BS", "abbreviation"},
+	{0x0009, "CHARACTER TABULATION", "control"},
+	{0x0009, "HORIZONTAL TABULATION", "control"},
+	{0x0009, "HT", "abbreviation"},
+	{0x0009, "TAB", "abbreviation"},
+	{0x000A, "LINE FEED", "control"},
+	{0x000A, "NEW LINE", "control"},
+	{0x000A, "END OF LINE", "control"},
+	{0x000A, "LF", "abbreviation"},
+	{0x000A, "NL", "abbreviation"},
+	{0x000A, "EOL", "abbreviation"},
+	{0x000B, "LINE TABULATION", "control"},
+	{0x000B, "VERTICAL TABULATION", "control"},
+	{0x000B, "VT", "abbreviation"},
+	{0x000C, "FORM FEED", "control"},
+	{0x000C, "FF", "abbreviation"},
+	{0x000D, "CARRIAGE RETURN", "control"},
+	{0x000D, "CR", "abbreviation"},
+	{0x000E, "SHIFT OUT", "control"},
+	{0x000E, "LOCKING-SHIFT ONE", "control"},
+	{0x000E, "SO", "abbreviation"},
+	{0x000F, "SHIFT IN", "control"},
+	{0x000F, "LOCKING-SHIFT ZERO", "control"},
+	{0x000F, "SI", "abbreviation"},
+	{0x0010, "DATA LINK ESCAPE", "control"},
+	{0x0010, "DLE", "abbreviation"},
+	{0x0011, "DEVICE CONTROL ONE", "control"},
+	{0x0011, "DC1", "abbreviation"},
+	{0x0012, "DEVICE CONTROL TWO", "control"},
+	{0x0012, "DC2", "abbreviation"},
+	{0x0013, "DEVICE CONTROL THREE", "control"},
+	{0x0013, "DC3", "abbreviation"},
+	{0x0014, "DEVICE CONTROL FOUR", "control"},
+	{0x0014, "DC4", "abbreviation"},
+	{0x0015, "NEGATIVE ACKNOWLEDGE", "control"},
+	{0x0015, "NAK", "abbreviation"},
+	{0x0016, "SYNCHRONOUS IDLE", "control"},
+	{0x0016, "SYN", "abbreviation"},
+	{0x0017, "END OF TRANSMISSION BLOCK", "control"},
+	{0x0017, "ETB", "abbreviation"},
+	{0x0018, "CANCEL", "control"},
+	{0x0018, "CAN", "abbreviation"},
+	{0x0019, "END OF MEDIUM", "control"},
+	{0x0019, "EOM", "abbreviation"},
+	{0x001A, "SUBSTITUTE", "control"},
+	{0x001A, "SUB", "abbreviation"},
+	{0x001B, "ESCAPE", "control"},
+	{0x001B, "ESC", "abbreviation"},
+	{0x001C, "INFORMATION SEPARATOR FOUR", "control"},
+	{0x001C, "FILE SEPARATOR", "control"},
+	{0x001C, "FS", "abbreviation"},
+	{0x001D, "INFORMATION SEPARATOR THREE", "control"},
+	{0x001D, "GROUP SEPARATOR", "control"},
+	{0x001D, "GS", "abbreviation"},
+	{0x001E, "INFORMATION SEPARATOR TWO", "control"},
+	{0x001E, "RECORD SEPARATOR", "control"},
+	{0x001E, "RS", "abbreviation"},
+	{0x001F, "INFORMATION SEPARATOR ONE", "control"},
+	{0x001F, "UNIT SEPARATOR", "control"},
+	{0x001F, "US", "abbreviation"},
+	{0x0020, "SP", "abbreviation"},
+	{0x007F, "DELETE", "control"},
+	{0x007F, "DEL", "abbreviation"},
+	{0x0080, "PADDING CHARACTER", "figment"},
+	{0x0080, "PAD", "abbreviation"},
+	{0x0081, "HIGH OCTET PRESET", "figment"},
+	{0x0081, "HOP", "abbreviation"},
+	{0x0082, "BREAK PERMITTED HERE", "control"},
+	{0x0082, "BPH", "abbreviation"},
+	{0x0083, "NO BREAK HERE", "control"},
+	{0x0083, "NBH", "abbreviation"},
+	{0x0084, "INDEX", "control"},
+	{0x0084, "IND", "abbreviation"},
+	{0x0085, "NEXT LINE", "control"},
+	{0x0085, "NEL", "abbreviation"},
+	{0x0086, "START OF SELECTED AREA", "control"},
+	{0x0086, "SSA", "abbreviation"},
+	{0x0087, "END OF SELECTED AREA", "control"},
+	{0x0087, "ESA", "abbreviation"},
+	{0x0088, "CHARACTER TABULATION SET", "control"},
+	{0x0088, "HORIZONTAL TABULATION SET", "control"},
+	{0x0088, "HTS", "abbreviation"},
+	{0x0089, "CHARACTER TABULATION WITH JUSTIFICATION", "control"},
+	{0x0089, "HORIZONTAL TABULATION WITH JUSTIFICATION", "control"},
+	{0x0089, "HTJ", "abbreviation"},
+	{0x008A, "LINE TABULATION SET", "control"},
+	{0x008A, "VERTICAL TABULATION SET", "control"},
+	{0x008A, "VTS", "abbreviation"},
+	{0x008B, "PARTIAL LINE FORWARD", "control"},
+	{0x008B, "PARTIAL LINE DOWN", "control"},
+	{0x008B, "PLD", "abbreviation"},
+	{0x008C, "PARTIAL LINE BACKWARD", "control"},
+	{0x008C, "PARTIAL LINE UP", "control"},
+	{0x008C, "PLU", "abbreviation"},
+	{0x008D, "REVERSE LINE FEED", "control"},
+	{0x008D, "REVERSE INDEX", "control"},
+	{0x008D, "RI", "abbreviation"},
+	{0x008E, "SINGLE SHIFT TWO", "control"},
+	{0x008E, "SINGLE-SHIFT-2", "control"},
+	{0x008E, "SS2", "abbreviation"},
+	{0x008F, "SINGLE SHIFT THREE", "control"},
+	{0x008F, "SINGLE-SHIFT-3", "control"},
+	{0x008F, "SS3", "abbreviation"},
+	{0x0090, "DEVICE CONTROL STRING", "control"},
+	{0x0090, "DCS", "abbreviation"},
+	{0x0091, "PRIVATE USE ONE", "control"},
+	{0x0091, "PRIVATE USE-1", "control"},
+	{0x0091, "PU1", "abbreviation"},
+	{0x0092, "PRIVATE USE TWO", "control"},
+	{0x0092, "PRIVATE USE-2", "control"},
+	{0x0092, "PU2", "abbreviation"},
+	{0x0093, "SET TRANSMIT STATE", "control"},
+	{0x0093, "STS", "abbreviation"},
+	{0x0094, "CANCEL CHARACTER", "control"},
+	{0x0094, "CCH", "abbreviation"},
+	{0x0095, "MESSAGE WAITING", "control"},
+	{0x0095, "MW", "abbreviation"},
+	{0x0096, "START OF GUARDED AREA", "control"},
+	{0x0096, "START OF PROTECTED AREA", "control"},
+	{0x0096, "SPA", "abbreviation"},
+	{0x0097, "END OF GUARDED AREA", "control"},
+	{0x0097, "END OF PROTECTED AREA", "control"},
+	{0x0097, "EPA", "abbreviation"},
+	{0x0098, "START OF STRING", "control"},
+	{0x0098, "SOS", "abbreviation"},
+	{0x0099, "SINGLE GRAPHIC CHARACTER INTRODUCER", "figment"},
+	{0x0099, "SGC", "abbreviation"},
+	{0x009A, "SINGLE CHARACTER INTRODUCER", "control"},
+	{0x009A, "SCI", "abbreviation"},
+	{0x009B, "CONTROL SEQUENCE INTRODUCER", "control"},
+	{0x009B, "CSI", "abbreviation"},
+	{0x009C, "STRING TERMINATOR", "control"},
+	{0x009C, "ST", "abbreviation"},
+	{0x009D, "OPERATING SYSTEM COMMAND", "control"},
+	{0x009D, "OSC", "abbreviation"},
+	{0x009E, "PRIVACY MESSAGE", "control"},
+	{0x009E, "PM", "abbreviation"},
+	{0x009F, "APPLICATION PROGRAM COMMAND", "control"},
+	{0x009F, "APC", "abbreviation"},
+	{0x00A0, "NBSP", "abbreviation"},
+	{0x00AD, "SHY", "abbreviation"},
+	{0x01A2, "LATIN CAPITAL LETTER GHA", "correction"},
+	{0x01A3, "LATIN SMALL LETTER GHA", "correction"},
+	{0x034F, "CGJ", "abbreviation"},
+	{0x061C, "ALM", "abbreviation"},
+	{0x0709, "SYRIAC SUBLINEAR COLON SKEWED LEFT", "correction"},
+	{0x0CDE, "KANNADA LETTER LLLA", "correction"},
+	{0x0E9D, "LAO LETTER FO FON", "correction"},
+	{0x0E9F, "LAO LETTER FO FAY", "correction"},
+	{0x0EA3, "LAO LETTER RO", "correction"},
+	{0x0EA5, "LAO LETTER LO", "correction"},
+	{0x0FD0, "TIBETAN MARK BKA- SHOG GI MGO RGYAN", "correction"},
+	{0x11EC, "HANGUL JONGSEONG YESIEUNG-KIYEOK", "correction"},
+	{0x11ED, "HANGUL JONGSEONG YESIEUNG-SSANGKIYEOK", "correction"},
+	{0x11EE, "HANGUL JONGSEONG SSANGYESIEUNG", "correction"},
+	{0x11EF, "HANGUL JONGSEONG YESIEUNG-KHIEUKH", "correction"},
+	{0x180B, "FVS1", "abbreviation"},
+	{0x180C, "FVS2", "abbreviation"},
+	{0x180D, "FVS3", "abbreviation"},
+	{0x180E, "MVS", "abbreviation"},
+	{0x180F, "FVS4", "abbreviation"},
+	{0x200B, "ZWSP", "abbreviation"},
+	{0x200C, "ZWNJ", "abbreviation"},
+	{0x200D, "ZWJ", "abbreviation"},
+	{0x200E, "LRM", "abbreviation"},
+	{0x200F, "RLM", "abbreviation"},
+	{0x202A, "LRE", "abbreviation"},
+	{0x202B, "RLE", "abbreviation"},
+	{0x202C, "PDF", "abbreviation"},
+	{0x202D, "LRO", "abbreviation"},
+	{0x202E, "RLO", "abbreviation"},
+	{0x202F, "NNBSP", "abbreviation"},
+	{0x205F, "MMSP", "abbreviation"},
+	{0x2060, "WJ", "abbreviation"},
+	{0x2066, "LRI", "abbreviation"},
+	{0x2067, "RLI", "abbreviation"},
+	{0x2068, "FSI", "abbreviation"},
+	{0x2069, "PDI", "abbreviation"},
+	{0x2118, "WEIERSTRASS ELLIPTIC FUNCTION", "correction"},
+	{0x2448, "MICR ON US SYMBOL", "correction"},
+	{0x2449, "MICR DASH SYMBOL", "correction"},
+	{0x2B7A, "LEFTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE VERTICAL STROKE", "correction"},
+	{0x2B7C, "RIGHTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE VERTICAL STROKE", "correction"},
+	{0xA015, "YI SYLLABLE ITERATION MARK", "correction"},
+	{0xAA6E, "MYANMAR LETTER KHAMTI LLA", "correction"},
+	{0xFE00, "VS1", "abbreviation"},
+	{0xFE01, "VS2", "abbreviation"},
+	{0xFE02, "VS3", "abbreviation"},
+	{0xFE03, "VS4", "abbreviation"},
+	{0xFE04, "VS5", "abbreviation"},
+	{0xFE05, "VS6", "abbreviation"},
+	{0xFE06, "VS7", "abbreviation"},
+	{0xFE07, "VS8", "abbreviation"},
+	{0xFE08, "VS9", "abbreviation"},
+	{0xFE09, "VS10", "abbreviation"},
+	{0xFE0A, "VS11", "abbreviation"},
+	{0xFE0B, "VS12", "abbreviation"},
+	{0xFE0C, "VS13", "abbreviation"},
+	{0xFE0D, "VS14", "abbreviation"},
+	{0xFE0E, "VS15", "abbreviation"},
+	{0xFE0F, "VS16", "abbreviation"},
+	{0xFE18, "PRESENTATION FORM FOR VERTICAL RIGHT WHITE LENTICULAR BRACKET", "correction"},
+	{0xFEFF, "BYTE ORDER MARK", "alternate"},
+	{0xFEFF, "BOM", "abbreviation"},
+	{0xFEFF, "ZWNBSP", "abbreviation"},
+	{0x122D4, "CUNEIFORM SIGN NU11 TENU", "correction"},
+	{0x122D5, "CUNEIFORM SIGN NU11 OVER NU11 BUR OVER BUR", "correction"},
+	{0x16E56, "MEDEFAIDRIN CAPITAL LETTER H", "correction"},
+	{0x16E57, "MEDEFAIDRIN CAPITAL LETTER NG", "correction"},
+	{0x16E76, "MEDEFAIDRIN SMALL LETTER H", "correction"},
+	{0x16E77, "MEDEFAIDRIN SMALL LETTER NG", "correction"},
+	{0x1B001, "HENTAIGANA LETTER E-1", "correction"},
+	{0x1D0C5, "BYZANTINE MUSICAL SYMBOL FTHORA SKLIRON CHROMA VASIS", "correction"},
+	{0xE0100, "VS17", "abbreviation"},
+	{0xE0101, "VS18", "abbreviation"},
+	{0xE0102, "VS19", "abbreviation"},
+	{0xE0103, "VS20", "abbreviation"},
+	{0xE0104, "VS21", "abbreviation"},
+	{0xE0105, "VS22", "abbreviation"},
+	{0xE0106, "VS23", "abbreviation"},
+	{0xE0107, "VS24", "abbreviation"},
+	{0xE0108, "VS25", "abbreviation"},
+	{0xE0109, "VS26", "abbreviation"},
+	{0xE010A, "VS27", "abbreviation"},
+	{0xE010B, "VS28", "abbreviation"},
+	{0xE010C, "VS29", "abbreviation"},
+	{0xE010D, "VS30", "abbreviation"},
+	{0xE010E, "VS31", "abbreviation"},
+	{0xE010F, "VS32", "abbreviation"},
+	{0xE0110, "VS33", "abbreviation"},
+	{0xE0111, "VS34", "abbreviation"},
+	{0xE0112, "VS35", "abbreviation"},
+	{0xE0113, "VS36", "abbreviation"},
+	{0xE0114, "VS37", "abbreviation"},
+	{0xE0115, "VS38", "abbreviation"},
+	{0xE0116, "VS39", "abbreviation"},
+	{0xE0117, "VS40", "abbreviation"},
+	{0xE0118, "VS41", "abbreviation"},
+	{0xE0119, "VS42", "abbreviation"},
+	{0xE011A, "VS43", "abbreviation"},
+	{0xE011B, "VS44", "abbreviation"},
+	{0xE011C, "VS45", "abbreviation"},
+	{0xE011D, "VS46", "abbreviation"},
+	{0xE011E, "VS47", "abbreviation"},
+	{0xE011F, "VS48", "abbreviation"},
+	{0xE0120, "VS49", "abbreviation"},
+	{0xE0121, "VS50", "abbreviation"},
+	{0xE0122, "VS51", "abbreviation"},
+	{0xE0123, "VS52", "abbreviation"},
+	{0xE0124, "VS53", "abbreviation"},
+	{0xE0125, "VS54", "abbreviation"},
+	{0xE0126, "VS55", "abbreviation"},
+	{0xE0127, "VS56", "abbreviation"},
+	{0xE0128, "VS57", "abbreviation"},
+	{0xE0129, "VS58", "abbreviation"},
+	{0xE012A, "VS59", "abbreviation"},
+	{0xE012B, "VS60", "abbreviation"},
+	{0xE012C, "VS61", "abbreviation"},
+	{0xE012D, "VS62", "abbreviation"},
+	{0xE012E, "VS63", "abbreviation"},
+	{0xE012F, "VS64", "abbreviation"},
+	{0xE0130, "VS65", "abbreviation"},
+	{0xE0131, "VS66", "abbreviation"},
+	{0xE0132, "VS67", "abbreviation"},
+	{0xE0133, "VS68", "abbreviation"},
+	{0xE0134, "VS69", "abbreviation"},
+	{0xE0135, "VS70", "abbreviation"},
+	{0xE0136, "VS71", "abbreviation"},
+	{0xE0137, "VS72", "abbreviation"},
+	{0xE0138, "VS73", "abbreviation"},
+	{0xE0139, "VS74", "abbreviation"},
+	{0xE013A, "VS75", "abbreviation"},
+	{0xE013B, "VS76", "abbreviation"},
+	{0xE013C, "VS77", "abbreviation"},
+	{0xE013D, "VS78", "abbreviation"},
+	{0xE013E, "VS79", "abbreviation"},
+	{0xE013F, "VS80", "abbreviation"},
+	{0xE0140, "VS81", "abbreviation"},
+	{0xE0141, "VS82", "abbreviation"},
+	{0xE0142, "VS83", "abbreviation"},
+	{0xE0143, "VS84", "abbreviation"},
+	{0xE0144, "VS85", "abbreviation"},
+	{0xE0145, "VS86", "abbreviation"},
+	{0xE0146, "VS87", "abbreviation"},
+	{0xE0147, "VS88", "abbreviation"},
+	{0xE0148, "VS89", "abbreviation"},
+	{0xE0149, "VS90", "abbreviation"},
+	{0xE014A, "VS91", "abbreviation"},
+	{0xE014B, "VS92", "abbreviation"},
+	{0xE014C, "VS93", "abbreviation"},
+	{0xE014D, "VS94", "abbreviation"},
+	{0xE014E, "VS95", "abbreviation"},
+	{0xE014F, "VS96", "abbreviation"},
+	{0xE0150, "VS97", "abbreviation"},
+	{0xE0151, "VS98", "abbreviation"},
+	{0xE0152, "VS99", "abbreviation"},
+	{0xE0153, "VS100", "abbreviation"},
+	{0xE0154, "VS101", "abbreviation"},
+	{0xE0155, "VS102", "abbreviation"},
+	{0xE0156, "VS103", "abbreviation"},
+	{0xE0157, "VS104", "abbreviation"},
+	{0xE0158, "VS105", "abbreviation"},
+	{0xE0159, "VS106", "abbreviation"},
+	{0xE015A, "VS107", "abbreviation"},
+	{0xE015B, "VS108", "abbreviation"},
+	{0xE015C, "VS109", "abbreviation"},
+	{0xE015D, "VS110", "abbreviation"},
+	{0xE015E, "VS111", "abbreviation"},
+	{0xE015F, "VS112", "abbreviation"},
+	{0xE0160, "VS113", "abbreviation"},
+	{0xE0161, "VS114", "abbreviation"},
+	{0xE0162, "VS115", "abbreviation"},
+	{0xE0163, "VS116", "abbreviation"},
+	{0xE0164, "VS117", "abbreviation"},
+	{0xE0165, "VS118", "abbreviation"},
+	{0xE0166, "VS119", "abbreviation"},
+	{0xE0167, "VS120", "abbreviation"},
+	{0xE0168, "VS121", "abbreviation"},
+	{0xE0169, "VS122", "abbreviation"},
+	{0xE016A, "VS123", "abbreviation"},
+	{0xE016B, "VS124", "abbreviation"},
+	{0xE016C, "VS125", "abbreviation"},
+	{0xE016D, "VS126", "abbreviation"},
+	{0xE016E, "VS127", "abbreviation"},
+	{0xE016F, "VS128", "abbreviation"},
+	{0xE0170, "VS129", "abbreviation"},
+	{0xE0171, "VS130", "abbreviation"},
+	{0xE0172, "VS131", "abbreviation"},
+	{0xE0173, "VS132", "abbreviation"},
+	{0xE0174, "VS133", "abbreviation"},
+	{0xE0175, "VS134", "abbreviation"},
+	{0xE0176, "VS135", "abbreviation"},
+	{0xE0177, "VS136", "abbreviation"},
+	{0xE0178, "VS137", "abbreviation"},
+	{0xE0179, "VS138", "abbreviation"},
+	{0xE017A, "VS139", "abbreviation"},
+	{0xE017B, "VS140", "abbreviation"},
+	{0xE017C, "VS141", "abbreviation"},
+	{0xE017D, "VS142", "abbreviation"},
+	{0xE017E, "VS143", "abbreviation"},
+	{0xE017F, "VS144", "abbreviation"},
+	{0xE0180, "VS145", "abbreviation"},
+	{0xE0181, "VS146", "abbreviation"},
+	{0xE0182, "VS147", "abbreviation"},
+	{0xE0183, "VS148", "abbreviation"},
+	{0xE0184, "VS149", "abbreviation"},
+	{0xE0185, "VS150", "abbreviation"},
+	{0xE0186, "VS151", "abbreviation"},
+	{0xE0187, "VS152", "abbreviation"},
+	{0xE0188, "VS153", "abbreviation"},
+	{0xE0189, "VS154", "abbreviation"},
+	{0xE018A, "VS155", "abbreviation"},
+	{0xE018B, "VS156", "abbreviation"},
+	{0xE018C, "VS157", "abbreviation"},
+	{0xE018D, "VS158", "abbreviation"},
+	{0xE018E, "VS159", "abbreviation"},
+	{0xE018F, "VS160", "abbreviation"},
+	{0xE0190, "VS161", "abbreviation"},
+	{0xE0191, "VS162", "abbreviation"},
+	{0xE0192, "VS163", "abbreviation"},
+	{0xE0193, "VS164", "abbreviation"},
+	{0xE0194, "VS165", "abbreviation"},
+	{0xE0195, "VS166", "abbreviation"},
+	{0xE0196, "VS167", "abbreviation"},
+	{0xE0197, "VS168", "abbreviation"},
+	{0xE0198, "VS169", "abbreviation"},
+	{0xE0199, "VS170", "abbreviation"},
+	{0xE019A, "VS171", "abbreviation"},
+	{0xE019B, "VS172", "abbreviation"},
+	{0xE019C, "VS173", "abbreviation"},
+	{0xE019D, "VS174", "abbreviation"},
+	{0xE019E, "VS175", "abbreviation"},
+	{0xE019F, "VS176", "abbreviation"},
+	{0xE01A0, "VS177", "abbreviation"},
+	{0xE01A1, "VS178", "abbreviation"},
+	{0xE01A2, "VS179", "abbreviation"},
+	{0xE01A3, "VS180", "abbreviation"},
+	{0xE01A4, "VS181", "abbreviation"},
+	{0xE01A5, "VS182", "abbreviation"},
+	{0xE01A6, "VS183", "abbreviation"},
+	{0xE01A7, "VS184", "abbreviation"},
+	{0xE01A8, "VS185", "abbreviation"},
+	{0xE01A9, "VS186", "abbreviation"},
+	{0xE01AA, "VS187", "abbreviation"},
+	{0xE01AB, "VS188", "abbreviation"},
+	{0xE01AC, "VS189", "abbreviation"},
+	{0xE01AD, "VS190", "abbreviation"},
+	{0xE01AE, "VS191", "abbreviation"},
+	{0xE01AF, "VS192", "abbreviation"},
+	{0xE01B0, "VS193", "abbreviation"},
+	{0xE01B1, "VS194", "abbreviation"},
+	{0xE01B2, "VS195", "abbreviation"},
+	{0xE01B3, "VS196", "abbreviation"},
+	{0xE01B4, "VS197", "abbreviation"},
+	{0xE01B5, "VS198", "abbreviation"},
+	{0xE01B6, "VS199", "abbreviation"},
+	{0xE01B7, "VS200", "abbreviation"},
+	{0xE01B8, "VS201", "abbreviation"},
+	{0xE01B9, "VS202", "abbreviation"},
+	{0xE01BA, "VS203", "abbreviation"},
+	{0xE01BB, "VS204", "abbreviation"},
+	{0xE01BC, "VS205", "abbreviation"},
+	{0xE01BD, "VS206", "abbreviation"},
+	{0xE01BE, "VS207", "abbreviation"},
+	{0xE01BF, "VS208", "abbreviation"},
+	{0xE01C0, "VS209", "abbreviation"},
+	{0xE01C1, "VS210", "abbreviation"},
+	{0xE01C2, "VS211", "abbreviation"},
+	{0xE01C3, "VS212", "abbreviation"},
+	{0xE01C4, "VS213", "abbreviation"},
+	{0xE01C5, "VS214", "abbreviation"},
+	{0xE01C6, "VS215", "abbreviation"},
+	{0xE01C7, "VS216", "abbreviation"},
+	{0xE01C8, "VS217", "abbreviation"},
+	{0xE01C9, "VS218", "abbreviation"},
+	{0xE01CA, "VS219", "abbreviation"},
+	{0xE01CB, "VS220", "abbreviation"},
+	{0xE01CC, "VS221", "abbreviation"},
+	{0xE01CD, "VS222", "abbreviation"},
+	{0xE01CE, "VS223", "abbreviation"},
+	{0xE01CF, "VS224", "abbreviation"},
+	{0xE01D0, "VS225", "abbreviation"},
+	{0xE01D1, "VS226", "abbreviation"},
+	{0xE01D2, "VS227", "abbreviation"},
+	{0xE01D3, "VS228", "abbreviation"},
+	{0xE01D4, "VS229", "abbreviation"},
+	{0xE01D5, "VS230", "abbreviation"},
+	{0xE01D6, "VS231", "abbreviation"},
+	{0xE01D7, "VS232", "abbreviation"},
+	{0xE01D8, "VS233", "abbreviation"},
+	{0xE01D9, "VS234", "abbreviation"},
+	{0xE01DA, "VS235", "abbreviation"},
+	{0xE01DB, "VS236", "abbreviation"},
+	{0xE01DC, "VS237", "abbreviation"},
+	{0xE01DD, "VS238", "abbreviation"},
+	{0xE01DE, "VS239", "abbreviation"},
+	{0xE01DF, "VS240", "abbreviation"},
+	{0xE01E0, "VS241", "abbreviation"},
+	{0xE01E1, "VS242", "abbreviation"},
+	{0xE01E2, "VS243", "abbreviation"},
+	{0xE01E3, "VS244", "abbreviation"},
+	{0xE01E4, "VS245", "abbreviation"},
+	{0xE01E5, "VS246", "abbreviation"},
+	{0xE01E6, "VS247", "abbreviation"},
+	{0xE01E7, "VS248", "abbreviation"},
+	{0xE01E8, "VS249", "abbreviation"},
+	{0xE01E9, "VS250", "abbreviation"},
+	{0xE01EA, "VS251", "abbreviation"},
+	{0xE01EB, "VS252", "abbreviation"},
+	{0xE01EC, "VS253", "abbreviation"},
+	{0xE01ED, "VS254", "abbreviation"},
+	{0xE01EE, "VS255", "abbreviation"},
+	{0xE01EF, "VS256", "abbreviation"},
+}
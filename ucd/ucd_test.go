@@ -1,8 +1,10 @@
 package ucd
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
+	"unicode"
 )
 
 func TestLookup(t *testing.T) {
@@ -32,7 +34,7 @@ func TestLookup(t *testing.T) {
 func TestLookupFail(t *testing.T) {
 	runes := []rune{
 		0x5FE,
-		0x4E01, // We don't support UCD ranges.
+		0x2A6E0, // just past a range.
 		0x10FFFE,
 		0x10FFFF,
 	}
@@ -53,8 +55,12 @@ func TestScanner(t *testing.T) {
 	}{
 		{0x0000, []rune{0x0000, 0x0001, 0x0002}},
 		{0x10000, []rune{0x10000, 0x10001, 0x10002}},
-		{0x100000, []rune{0x100000, 0x10FFFD}},
+		{0x100000, []rune{0x100000, 0x100001, 0x100002}},
+		{0x10FFFC, []rune{0x10FFFC, 0x10FFFD}},
 		{0x10FFFD, []rune{0x10FFFD}},
+		{0x4DBE, []rune{0x4DBE, 0x4DBF, 0x4DC0}},
+		{0x9FFE, []rune{0x9FFE, 0x9FFF, 0xA000}},
+		{0x4E00, []rune{0x4E00, 0x4E01, 0x4E02}},
 	} {
 		var have []rune
 		s := NewScanner(d.start)
@@ -68,3 +74,286 @@ func TestScanner(t *testing.T) {
 		}
 	}
 }
+
+func TestRecordFields(t *testing.T) {
+	type numbers struct {
+		decimal, digit     int
+		isDecimal, isDigit bool
+		numeric            float64
+		isNumeric          bool
+	}
+	for _, d := range []struct {
+		r          rune
+		cat        string
+		ccc        int
+		bidi       string
+		mirrored   bool
+		dtype      string
+		dmap       []rune
+		num        numbers
+		u1name     string
+		up, lo, ti rune
+	}{
+		{r: 'A', cat: "Lu", bidi: "L", up: 'A', lo: 'a', ti: 'A'},
+		{r: 'a', cat: "Ll", bidi: "L", up: 'A', lo: 'a', ti: 'A'},
+		{r: '(', cat: "Ps", bidi: "ON", mirrored: true, u1name: "OPENING PARENTHESIS", up: '(', lo: '(', ti: '('},
+		{r: 0x0301, cat: "Mn", ccc: 230, bidi: "NSM", u1name: "NON-SPACING ACUTE", up: 0x0301, lo: 0x0301, ti: 0x0301},
+		{r: 0x00E9, cat: "Ll", bidi: "L", dmap: []rune{'e', 0x0301}, u1name: "LATIN SMALL LETTER E ACUTE", up: 0x00C9, lo: 0x00E9, ti: 0x00C9},
+		{r: 0x00BD, cat: "No", bidi: "ON", dtype: "fraction", dmap: []rune{'1', 0x2044, '2'}, num: numbers{numeric: 0.5, isNumeric: true}, u1name: "FRACTION ONE HALF", up: 0x00BD, lo: 0x00BD, ti: 0x00BD},
+		{r: 0x2460, cat: "No", bidi: "ON", dtype: "circle", dmap: []rune{'1'}, num: numbers{digit: 1, isDigit: true, numeric: 1, isNumeric: true}, up: 0x2460, lo: 0x2460, ti: 0x2460},
+		{r: 0x0664, cat: "Nd", bidi: "AN", num: numbers{4, 4, true, true, 4, true}, up: 0x0664, lo: 0x0664, ti: 0x0664},
+		{r: 0x0F33, cat: "No", bidi: "L", num: numbers{numeric: -0.5, isNumeric: true}, up: 0x0F33, lo: 0x0F33, ti: 0x0F33},
+		{r: 0x01C5, cat: "Lt", bidi: "L", dtype: "compat", dmap: []rune{'D', 0x017E}, u1name: "LATIN LETTER CAPITAL D SMALL Z HACEK", up: 0x01C4, lo: 0x01C6, ti: 0x01C5},
+		{r: 0x000A, cat: "Cc", bidi: "B", u1name: "LINE FEED (LF)", up: 0x000A, lo: 0x000A, ti: 0x000A},
+	} {
+		rec := Lookup(d.r)
+		if rec == nil {
+			t.Errorf("Lookup(%04X)=nil", d.r)
+			continue
+		}
+		if have := rec.Category(); have != d.cat {
+			t.Errorf("%04X: Category()=%q, want=%q", d.r, have, d.cat)
+		}
+		if have := rec.CombiningClass(); have != d.ccc {
+			t.Errorf("%04X: CombiningClass()=%d, want=%d", d.r, have, d.ccc)
+		}
+		if have := rec.BidiClass(); have != d.bidi {
+			t.Errorf("%04X: BidiClass()=%q, want=%q", d.r, have, d.bidi)
+		}
+		if have := rec.Mirrored(); have != d.mirrored {
+			t.Errorf("%04X: Mirrored()=%v, want=%v", d.r, have, d.mirrored)
+		}
+		if typ, m := rec.Decomposition(); typ != d.dtype || !reflect.DeepEqual(m, d.dmap) {
+			t.Errorf("%04X: Decomposition()=%q, %U, want=%q, %U", d.r, typ, m, d.dtype, d.dmap)
+		}
+		var have numbers
+		have.decimal, have.isDecimal = rec.Decimal()
+		have.digit, have.isDigit = rec.Digit()
+		have.numeric, have.isNumeric = rec.Numeric()
+		if have != d.num {
+			t.Errorf("%04X: numeric values=%+v, want=%+v", d.r, have, d.num)
+		}
+		if have := string(rec.Unicode1Name()); have != d.u1name {
+			t.Errorf("%04X: Unicode1Name()=%q, want=%q", d.r, have, d.u1name)
+		}
+		if up, lo, ti := rec.Upper(), rec.Lower(), rec.Title(); up != d.up || lo != d.lo || ti != d.ti {
+			t.Errorf("%04X: Upper, Lower, Title()=%U, %U, %U, want=%U, %U, %U", d.r, up, lo, ti, d.up, d.lo, d.ti)
+		}
+	}
+}
+
+func TestRanges(t *testing.T) {
+	for _, d := range []struct {
+		r    rune
+		name string
+		cat  string
+	}{
+		{0x3400, "CJK UNIFIED IDEOGRAPH-3400", "Lo"},
+		{0x4E2D, "CJK UNIFIED IDEOGRAPH-4E2D", "Lo"},
+		{0x9FFF, "CJK UNIFIED IDEOGRAPH-9FFF", "Lo"},
+		{0x18D08, "TANGUT IDEOGRAPH-18D08", "Lo"},
+		{0x1FAF6, "HEART HANDS", "So"},
+		{0x20BB7, "CJK UNIFIED IDEOGRAPH-20BB7", "Lo"},
+		{0xAC00, "HANGUL SYLLABLE GA", "Lo"},
+		{0xAC01, "HANGUL SYLLABLE GAG", "Lo"},
+		{0xD4DB, "HANGUL SYLLABLE PWILH", "Lo"},
+		{0xD7A3, "HANGUL SYLLABLE HIH", "Lo"},
+		{0xC5D0, "HANGUL SYLLABLE E", "Lo"},
+		{0xE123, "<Private Use>", "Co"},
+		{0xD800, "<Non Private Use High Surrogate>", "Cs"},
+	} {
+		rec := Lookup(d.r)
+		if rec == nil {
+			t.Errorf("Lookup(%04X)=nil", d.r)
+			continue
+		}
+		if rec.Rune() != d.r || string(rec.Name()) != d.name || rec.Category() != d.cat {
+			t.Errorf("Lookup(%04X)=%04X %q %s, want=%04X %q %s", d.r, rec.Rune(), rec.Name(), rec.Category(), d.r, d.name, d.cat)
+		}
+	}
+
+	var n int
+	for s := NewScanner(0xAC00); !s.Done() && s.Record().Rune() <= 0xD7A3; s.Next() {
+		n++
+	}
+	if want := 0xD7A3 - 0xAC00 + 1; n != want {
+		t.Errorf("scanned %d Hangul syllables, want=%d", n, want)
+	}
+}
+
+func TestTables(t *testing.T) {
+	for _, d := range []struct {
+		r                       rune
+		block, script, eaw, age string
+	}{
+		{'A', "Basic Latin", "Latin", "Na", "1.1"},
+		{0x03BB, "Greek and Coptic", "Greek", "A", "1.1"},
+		{0x4E2D, "CJK Unified Ideographs", "Han", "W", "1.1"},
+		{0x20AC, "Currency Symbols", "Common", "A", "2.1"},
+		{0x1FAF6, "Symbols and Pictographs Extended-A", "Common", "W", "14.0"},
+		{0x0378, "Greek and Coptic", "Unknown", "N", ""},
+		{0x2FE0, "No_Block", "Unknown", "N", ""},
+	} {
+		have := []string{
+			spanValue(blocks, d.r, "No_Block"),
+			spanValue(scripts, d.r, "Unknown"),
+			spanValue(eastAsianWidths, d.r, "N"),
+			spanValue(ages, d.r, ""),
+		}
+		if want := []string{d.block, d.script, d.eaw, d.age}; !reflect.DeepEqual(have, want) {
+			t.Errorf("%04X: block, script, width, age=%q, want=%q", d.r, have, want)
+		}
+	}
+	if !unicode.Is(properties["White_Space"], ' ') || unicode.Is(properties["White_Space"], 'x') {
+		t.Errorf("White_Space property is wrong")
+	}
+	if !unicode.Is(emojiProperties["Emoji_Presentation"], 0x1F600) || unicode.Is(emojiProperties["Emoji_Presentation"], '#') {
+		t.Errorf("Emoji_Presentation property is wrong")
+	}
+	var zwj bool
+	for _, a := range aliases {
+		zwj = zwj || a == alias{0x200D, "ZWJ", "abbreviation"}
+	}
+	if !zwj {
+		t.Errorf("no ZWJ alias for U+200D")
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	runes := []rune{'A', 0x03BB, 0x20AC, 0x4E2D, 0xAC01, 0x1D11E, 0x1FAF6, 0xE0001}
+	for i := 0; i < b.N; i++ {
+		Lookup(runes[i%len(runes)])
+	}
+}
+
+func BenchmarkScan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for s := NewScanner(0); !s.Done() && s.Record().Rune() < 0x3000; s.Next() {
+		}
+	}
+}
+
+func BenchmarkScanNames(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := &Scanner{}
+		for n := 0; !s.Done() && n < 1000; s.Next() {
+			s.Record().Name()
+			n++
+		}
+	}
+}
+
+func TestScanAll(t *testing.T) {
+	var n int
+	prev := rune(-1)
+	for s := (&Scanner{}); !s.Done(); s.Next() {
+		rec := s.Record()
+		r := rec.Rune()
+		if r <= prev {
+			t.Fatalf("scanned %04X after %04X", r, prev)
+		}
+		if want := Lookup(r); string(rec) != string(want) {
+			t.Fatalf("scanned %q, Lookup(%04X)=%q", rec, r, want)
+		}
+		prev = r
+		n++
+	}
+	// Graphic and format characters in Unicode 14.0.0, plus controls,
+	// private use characters and surrogates.
+	if want := 144697 + 65 + 137468 + 2048; n != want {
+		t.Errorf("scanned %d characters, want=%d", n, want)
+	}
+}
+
+func TestRawUCD(t *testing.T) {
+	raw := RawUCD
+	for _, want := range []string{
+		"\n0041;LATIN CAPITAL LETTER A;Lu;0;L;;;;;N;;;;0061;\n",
+		"\n4E00;<CJK Ideograph, First>;Lo;0;L;;;;;N;;;;;\n",
+	} {
+		if !bytes.Contains(raw, []byte(want)) {
+			t.Errorf("RawUCD lacks %q", want)
+		}
+	}
+	if raw[0] != '\n' || raw[len(raw)-1] != '\n' {
+		t.Errorf("RawUCD does not start and end with a newline")
+	}
+}
+
+func TestBlocksAndScripts(t *testing.T) {
+	for _, d := range []struct {
+		r             rune
+		block, script string
+	}{
+		{'A', "Basic Latin", "Latin"},
+		{0x1D505, "Mathematical Alphanumeric Symbols", "Common"},
+		{0x0301, "Combining Diacritical Marks", "Inherited"},
+		{0x4E2D, "CJK Unified Ideographs", "Han"},
+		{0x1FAF6, "Symbols and Pictographs Extended-A", "Common"},
+	} {
+		rec := Lookup(d.r)
+		if block, script := rec.Block(), rec.Script(); block != d.block || script != d.script {
+			t.Errorf("%04X: Block, Script()=%q, %q, want=%q, %q", d.r, block, script, d.block, d.script)
+		}
+	}
+
+	blocks := Blocks()
+	if len(blocks) != 320 || blocks[0] != (Range{0, 0x7F, "Basic Latin"}) {
+		t.Errorf("Blocks()=%d blocks from %v, want=320 from Basic Latin", len(blocks), blocks[0])
+	}
+	for _, name := range []string{"Mathematical Alphanumeric Symbols", "mathematical_alphanumeric_symbols", "MathematicalAlphanumericSymbols"} {
+		if have, ok := LookupBlock(name); !ok || have != (Range{0x1D400, 0x1D7FF, "Mathematical Alphanumeric Symbols"}) {
+			t.Errorf("LookupBlock(%q)=%v, %v", name, have, ok)
+		}
+	}
+	if have, ok := LookupBlock("No Such Block"); ok {
+		t.Errorf("LookupBlock(No Such Block)=%v, want none", have)
+	}
+
+	if have, want := ScriptRanges("old italic"), []Range{{0x10300, 0x10323, "Old_Italic"}, {0x1032D, 0x1032F, "Old_Italic"}}; !reflect.DeepEqual(have, want) {
+		t.Errorf("ScriptRanges(old italic)=%v, want=%v", have, want)
+	}
+	if have := ScriptRanges("Klingon"); have != nil {
+		t.Errorf("ScriptRanges(Klingon)=%v, want=nil", have)
+	}
+	scripts := Scripts()
+	if len(scripts) != 161 || scripts[0] != "Adlam" {
+		t.Errorf("Scripts()=%d scripts from %q, want=161 from Adlam", len(scripts), scripts[0])
+	}
+}
+
+func TestProperties(t *testing.T) {
+	for _, d := range []struct {
+		r     rune
+		eaw   string
+		age   string
+		props []string
+	}{
+		{' ', "Na", "1.1", []string{"Pattern_White_Space", "White_Space"}},
+		{'7', "Na", "1.1", []string{"ASCII_Hex_Digit", "Emoji", "Emoji_Component", "Hex_Digit"}},
+		{0x20AC, "A", "2.1", nil},
+		{0x200D, "N", "1.1", []string{"Emoji_Component", "Join_Control"}},
+		{0x4E2D, "W", "1.1", []string{"Ideographic", "Unified_Ideograph"}},
+		{0x1F600, "W", "6.1", []string{"Emoji", "Emoji_Presentation", "Extended_Pictographic"}},
+		{0x1FAF6, "W", "14.0", []string{"Emoji", "Emoji_Modifier_Base", "Emoji_Presentation", "Extended_Pictographic"}},
+	} {
+		rec := Lookup(d.r)
+		if eaw, age := rec.EastAsianWidth(), rec.Age(); eaw != d.eaw || age != d.age {
+			t.Errorf("%04X: EastAsianWidth, Age()=%q, %q, want=%q, %q", d.r, eaw, age, d.eaw, d.age)
+		}
+		if have := rec.Properties(); !reflect.DeepEqual(have, d.props) {
+			t.Errorf("%04X: Properties()=%q, want=%q", d.r, have, d.props)
+		}
+		for _, p := range d.props {
+			if !rec.HasProperty(p) {
+				t.Errorf("%04X: HasProperty(%q)=false", d.r, p)
+			}
+		}
+	}
+	if rec := Lookup(' '); !rec.HasProperty("white space") || rec.HasProperty("Emoji") || rec.HasProperty("No_Such_Property") {
+		t.Errorf("HasProperty matches wrongly")
+	}
+	if names := PropertyNames(); len(names) != 40 {
+		t.Errorf("PropertyNames()=%q, want 40 names", names)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gaal/shstat/ucd"
+)
+
+// lookalikes are characters commonly mistaken for ASCII that do not
+// decompose to it.
+var lookalikes = map[rune]string{
+	0x00D7: "x", 0x0131: "i", 0x01C3: "!", 0x02B9: "'", 0x02BC: "'",
+	0x02C6: "^", 0x02CB: "`", 0x02D0: ":", 0x02DC: "~", 0x0589: ":",
+	0x2010: "-", 0x2011: "-", 0x2012: "-", 0x2013: "-", 0x2014: "-",
+	0x2015: "-", 0x2018: "'", 0x2019: "'", 0x201A: ",", 0x201B: "'",
+	0x201C: "\"", 0x201D: "\"", 0x201E: "\"", 0x201F: "\"", 0x2032: "'",
+	0x2033: "\"", 0x2039: "<", 0x203A: ">", 0x2044: "/", 0x2212: "-",
+	0x2215: "/", 0x2216: "\\", 0x2217: "*", 0x2223: "|", 0x2236: ":",
+	0x2758: "|", 0x3008: "<", 0x3009: ">",
+}
+
+// analyze shows each code point of s, one per row: its byte offset, UTF-8
+// bytes, code point, general category and name. Rows are flagged for
+// invalid UTF-8, invisible characters, bidi controls and characters that
+// may be confused with others.
+func analyze(s string) {
+	major := majorScript(s)
+	for off := 0; off < len(s); {
+		r, size := utf8.DecodeRuneInString(s[off:])
+		bytes := fmt.Sprintf("% x", s[off:off+size])
+		if r == utf8.RuneError && size == 1 {
+			fmt.Printf("%6d  %-11s  invalid UTF-8\n", off, bytes)
+			off += size
+			continue
+		}
+		rec := ucd.Lookup(r)
+		cat, name := "Cn", "<unassigned>"
+		if rec != nil {
+			cat, name = rec.Category(), string(rec.DisplayName())
+		}
+		flags := charFlags(r, rec, major)
+		fmt.Printf("%6d  %-11s  %-8s %-2s  %s %s", off, bytes, fmt.Sprintf("U+%04X", r), cat, glyph(r, rec), name)
+		if len(flags) > 0 {
+			fmt.Printf("  (%s)", strings.Join(flags, "; "))
+		}
+		fmt.Println()
+		off += size
+	}
+}
+
+// glyph returns the character r in brackets, for display. Characters that
+// would garble the terminal, such as controls, show as a space, and
+// combining marks on a dotted circle.
+func glyph(r rune, rec ucd.Record) string {
+	if rec == nil {
+		return "[ ]"
+	}
+	switch cat := rec.Category(); {
+	case cat == "Cc", cat == "Cs", cat == "Co", isInvisible(r, rec), rec.HasProperty("Bidi_Control"):
+		return "[ ]"
+	case cat == "Mn" || cat == "Me":
+		return fmt.Sprintf("[◌%c]", r)
+	}
+	return fmt.Sprintf("[%c]", r)
+}
+
+// charFlags returns what may be surprising about the character r in text
+// mostly in the script major.
+func charFlags(r rune, rec ucd.Record, major string) []string {
+	if rec == nil {
+		return []string{"unassigned"}
+	}
+	var flags []string
+	if isInvisible(r, rec) {
+		flags = append(flags, "invisible")
+	}
+	if rec.HasProperty("Bidi_Control") {
+		flags = append(flags, "bidi control")
+	}
+	if s, ok := asASCII(r); ok {
+		flags = append(flags, fmt.Sprintf("confusable with %q", s))
+	} else if sc := rec.Script(); homoglyphScript(sc) && homoglyphScript(major) && sc != major && strings.HasPrefix(rec.Category(), "L") {
+		flags = append(flags, fmt.Sprintf("confusable: %s in %s text", sc, major))
+	}
+	return flags
+}
+
+// isInvisible reports whether the character shows no glyph, other than
+// ASCII space and the tab, line feed and carriage return.
+func isInvisible(r rune, rec ucd.Record) bool {
+	switch r {
+	case ' ', '\t', '\n', '\r':
+		return false
+	}
+	switch rec.Category() {
+	case "Cc", "Cf", "Zs", "Zl", "Zp":
+		return true
+	}
+	return rec.HasProperty("White_Space") || rec.HasProperty("Variation_Selector") ||
+		rec.HasProperty("Other_Default_Ignorable_Code_Point")
+}
+
+// asASCII returns the ASCII string that a non-ASCII character looks like,
+// if it is a lookalike or decomposes entirely to ASCII, as the fullwidth
+// and mathematical letters do.
+func asASCII(r rune) (string, bool) {
+	if r < utf8.RuneSelf {
+		return "", false
+	}
+	if s, ok := lookalikes[r]; ok {
+		return s, true
+	}
+	d := decompose(r)
+	for _, c := range d {
+		if c >= utf8.RuneSelf {
+			return "", false
+		}
+	}
+	return string(d), len(d) > 0 && string(d) != string(r)
+}
+
+// decompose returns the full compatibility decomposition of r.
+func decompose(r rune) []rune {
+	rec := ucd.Lookup(r)
+	if rec == nil {
+		return []rune{r}
+	}
+	_, m := rec.Decomposition()
+	if m == nil {
+		return []rune{r}
+	}
+	var d []rune
+	for _, c := range m {
+		d = append(d, decompose(c)...)
+	}
+	return d
+}
+
+// homoglyphScript reports whether letters of the script are often confused
+// with those of the others.
+func homoglyphScript(sc string) bool {
+	return sc == "Latin" || sc == "Greek" || sc == "Cyrillic"
+}
+
+// majorScript returns the script of most of the letters of s.
+func majorScript(s string) string {
+	n := map[string]int{}
+	major := ""
+	for _, r := range s {
+		rec := ucd.Lookup(r)
+		if rec == nil || !strings.HasPrefix(rec.Category(), "L") {
+			continue
+		}
+		sc := rec.Script()
+		n[sc]++
+		if n[sc] > n[major] {
+			major = sc
+		}
+	}
+	return major
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gaal/shstat/ucd"
+)
+
+func TestCharFlags(t *testing.T) {
+	for _, d := range []struct {
+		r     rune
+		major string
+		want  []string
+	}{
+		{'a', "Latin", nil},
+		{'a', "", nil},
+		{0x00E9, "Latin", nil}, // é
+		{0x0430, "Latin", []string{"confusable: Cyrillic in Latin text"}}, // а
+		{'a', "Cyrillic", []string{"confusable: Latin in Cyrillic text"}},
+		{0x0430, "Cyrillic", nil},
+		{0x03BF, "Latin", []string{"confusable: Greek in Latin text"}}, // ο
+		{0x05D0, "Latin", nil},                                         // א
+		{0xFF41, "Latin", []string{`confusable with "a"`}},             // ａ
+		{0x1D41A, "Latin", []string{`confusable with "a"`}},            // 𝐚
+		{0x2019, "Latin", []string{`confusable with "'"`}},
+		{0x2212, "", []string{`confusable with "-"`}},
+		{0x200B, "Latin", []string{"invisible"}}, // ZERO WIDTH SPACE
+		{0x200D, "Latin", []string{"invisible"}}, // ZERO WIDTH JOINER
+		{0xFEFF, "Latin", []string{"invisible"}}, // ZERO WIDTH NO-BREAK SPACE
+		{0x00A0, "Latin", []string{"invisible", `confusable with " "`}},
+		{0x202E, "Latin", []string{"invisible", "bidi control"}}, // RIGHT-TO-LEFT OVERRIDE
+		{0x2067, "Latin", []string{"invisible", "bidi control"}}, // RIGHT-TO-LEFT ISOLATE
+		{0x200F, "Latin", []string{"invisible", "bidi control"}}, // RIGHT-TO-LEFT MARK
+		{0x0378, "Latin", []string{"unassigned"}},
+	} {
+		have := charFlags(d.r, ucd.Lookup(d.r), d.major)
+		if !reflect.DeepEqual(have, d.want) {
+			t.Errorf("charFlags(%U, %q)=%q, want=%q", d.r, d.major, have, d.want)
+		}
+	}
+}
+
+func TestIsInvisible(t *testing.T) {
+	for _, d := range []struct {
+		r    rune
+		want bool
+	}{
+		{'a', false},
+		{' ', false},
+		{'\t', false},
+		{'\n', false},
+		{0x0000, true},
+		{0x007F, true},
+		{0x00A0, true},
+		{0x00AD, true}, // SOFT HYPHEN
+		{0x200B, true},
+		{0x2060, true}, // WORD JOINER
+		{0x2028, true}, // LINE SEPARATOR
+		{0x3000, true}, // IDEOGRAPHIC SPACE
+		{0xFE0F, true}, // VARIATION SELECTOR-16
+		{0x115F, true}, // HANGUL CHOSEONG FILLER
+		{0x0301, false},
+		{0x2019, false},
+	} {
+		if have := isInvisible(d.r, ucd.Lookup(d.r)); have != d.want {
+			t.Errorf("isInvisible(%U)=%v, want=%v", d.r, have, d.want)
+		}
+	}
+}
+
+func TestAsASCII(t *testing.T) {
+	for _, d := range []struct {
+		r      rune
+		want   string
+		wantOK bool
+	}{
+		{'a', "", false},
+		{0x00E9, "", false}, // é decomposes to e and a combining accent.
+		{0x0430, "", false},
+		{0x2126, "", false}, // OHM SIGN decomposes to Ω.
+		{0xFF21, "A", true},
+		{0x1D400, "A", true},
+		{0x2160, "I", true},  // ROMAN NUMERAL ONE
+		{0xFB01, "fi", true}, // ﬁ
+		{0x2024, ".", true},  // ONE DOT LEADER
+		{0x00D7, "x", true},
+		{0x2013, "-", true},
+		{0x201C, `"`, true},
+		{0x00A0, " ", true},
+	} {
+		have, ok := asASCII(d.r)
+		if have != d.want || ok != d.wantOK {
+			t.Errorf("asASCII(%U)=%q, %v, want=%q, %v", d.r, have, ok, d.want, d.wantOK)
+		}
+	}
+}
+
+func TestMajorScript(t *testing.T) {
+	for _, d := range []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"123 !?", ""},
+		{"paypal", "Latin"},
+		{"pаypаl", "Latin"}, // with two Cyrillic а.
+		{"рaypal.com", "Latin"},
+		{"привет, world", "Cyrillic"},
+		{"αβγ abcd", "Latin"},
+		{"αβγδ abc", "Greek"},
+		{"日本語", "Han"},
+		{"ab́c", "Latin"},
+	} {
+		if have := majorScript(d.in); have != d.want {
+			t.Errorf("majorScript(%q)=%q, want=%q", d.in, have, d.want)
+		}
+	}
+}
+
+func TestGlyph(t *testing.T) {
+	for _, d := range []struct {
+		r    rune
+		want string
+	}{
+		{'a', "[a]"},
+		{0x00BD, "[½]"},
+		{'\n', "[ ]"},
+		{0x0000, "[ ]"},
+		{0x007F, "[ ]"},
+		{0x200B, "[ ]"},
+		{0x202E, "[ ]"},
+		{0x200E, "[ ]"}, // LEFT-TO-RIGHT MARK
+		{0xE000, "[ ]"},
+		{0x0378, "[ ]"},
+		{0x0301, "[◌́]"},
+	} {
+		if have := glyph(d.r, ucd.Lookup(d.r)); have != d.want {
+			t.Errorf("glyph(%U)=%q, want=%q", d.r, have, d.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gaal/shstat/ucd"
+)
+
+// query is a conjunction of conditions on the properties of characters.
+type query []func(rec ucd.Record) bool
+
+func (q query) match(rec ucd.Record) bool {
+	for _, f := range q {
+		if !f(rec) {
+			return false
+		}
+	}
+	return true
+}
+
+// newQuery returns the query the property flags ask for, or nil if they
+// ask for none.
+func newQuery() (query, error) {
+	var q query
+	if *category != "" {
+		cats := strings.Split(*category, ",")
+		q = append(q, func(rec ucd.Record) bool {
+			for _, c := range cats {
+				if strings.HasPrefix(rec.Category(), c) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	if *bidi != "" {
+		q = append(q, oneOf(*bidi, ucd.Record.BidiClass))
+	}
+	if *width != "" {
+		q = append(q, oneOf(*width, ucd.Record.EastAsianWidth))
+	}
+	if *ccc != "" {
+		n, err := strconv.Atoi(*ccc)
+		if err != nil {
+			return nil, fmt.Errorf("bad combining class %q", *ccc)
+		}
+		q = append(q, func(rec ucd.Record) bool { return rec.CombiningClass() == n })
+	}
+	if *numeric != "" {
+		v, err := parseNumeric(*numeric)
+		if err != nil {
+			return nil, err
+		}
+		q = append(q, func(rec ucd.Record) bool {
+			n, ok := rec.Numeric()
+			return ok && n == v
+		})
+	}
+	if *props != "" {
+		for _, p := range strings.Split(*props, ",") {
+			name, ok := propertyName(p)
+			if !ok {
+				return nil, fmt.Errorf("unknown property %q; known properties are %s", p, strings.Join(ucd.PropertyNames(), ", "))
+			}
+			q = append(q, func(rec ucd.Record) bool { return rec.HasProperty(name) })
+		}
+	}
+	if *age != "" {
+		f, err := ageQuery(*age)
+		if err != nil {
+			return nil, err
+		}
+		q = append(q, f)
+	}
+	return q, nil
+}
+
+// oneOf returns a condition that a property is one of a comma-separated
+// list of values, ignoring case.
+func oneOf(list string, prop func(ucd.Record) string) func(ucd.Record) bool {
+	vals := strings.Split(list, ",")
+	return func(rec ucd.Record) bool {
+		have := prop(rec)
+		for _, v := range vals {
+			if strings.EqualFold(have, v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// propertyName returns the name of the binary property p, matched
+// ignoring case, spaces, underscores and hyphens.
+func propertyName(p string) (string, bool) {
+	loose := strings.NewReplacer(" ", "", "_", "", "-", "")
+	for _, name := range ucd.PropertyNames() {
+		if strings.EqualFold(loose.Replace(name), loose.Replace(p)) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// parseNumeric parses a numeric value, which may be a fraction like 1/2.
+func parseNumeric(s string) (float64, error) {
+	parts := strings.SplitN(s, "/", 2)
+	n, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("bad numeric value %q", s)
+	}
+	if len(parts) == 2 {
+		d, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || d == 0 {
+			return 0, fmt.Errorf("bad numeric value %q", s)
+		}
+		n /= d
+	}
+	return n, nil
+}
+
+// ageQuery returns a condition on the version of Unicode characters were
+// added in. The version, such as 6.1, may be preceded by a comparison: <,
+// <=, >, >= or =. Major versions such as 6 stand for all their minor
+// versions, so that >6 means 7.0 and later.
+func ageQuery(s string) (func(ucd.Record) bool, error) {
+	i := strings.IndexAny(s, "0123456789")
+	if i < 0 {
+		return nil, fmt.Errorf("bad Unicode version %q", s)
+	}
+	op := s[:i]
+	want, minor, err := parseVersion(s[i:])
+	if err != nil {
+		return nil, fmt.Errorf("bad Unicode version %q", s)
+	}
+	cmp := func(rec ucd.Record) (int, bool) {
+		have, _, err := parseVersion(rec.Age())
+		if err != nil {
+			return 0, false // unassigned.
+		}
+		if !minor {
+			have[1] = 0
+		}
+		for i := range have {
+			if have[i] != want[i] {
+				if have[i] < want[i] {
+					return -1, true
+				}
+				return 1, true
+			}
+		}
+		return 0, true
+	}
+	var f func(c int) bool
+	switch op {
+	case "", "=", "==":
+		f = func(c int) bool { return c == 0 }
+	case "<":
+		f = func(c int) bool { return c < 0 }
+	case "<=":
+		f = func(c int) bool { return c <= 0 }
+	case ">":
+		f = func(c int) bool { return c > 0 }
+	case ">=":
+		f = func(c int) bool { return c >= 0 }
+	default:
+		return nil, fmt.Errorf("bad comparison %q in Unicode version %q", op, s)
+	}
+	return func(rec ucd.Record) bool {
+		c, ok := cmp(rec)
+		return ok && f(c)
+	}, nil
+}
+
+// parseVersion parses a Unicode version, such as 6.1 or 6, and reports
+// whether it has a minor version.
+func parseVersion(s string) (v [2]int, minor bool, err error) {
+	parts := strings.SplitN(s, ".", 2)
+	if v[0], err = strconv.Atoi(parts[0]); err != nil {
+		return v, false, err
+	}
+	if len(parts) == 2 {
+		v[1], err = strconv.Atoi(parts[1])
+		return v, true, err
+	}
+	return v, false, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gaal/shstat/ucd"
+)
+
+func TestParseNumeric(t *testing.T) {
+	for _, d := range []struct {
+		in   string
+		want float64
+	}{
+		{"0", 0},
+		{"5", 5},
+		{"-1/2", -0.5},
+		{"1/2", 0.5},
+		{"3/4", 0.75},
+		{"1000000", 1e6},
+	} {
+		have, err := parseNumeric(d.in)
+		if err != nil {
+			t.Errorf("parseNumeric(%q) returned unexpected error=%v", d.in, err)
+			continue
+		}
+		if have != d.want {
+			t.Errorf("parseNumeric(%q)=%v, want=%v", d.in, have, d.want)
+		}
+	}
+	for _, in := range []string{"", "x", "1/", "/2", "1/x", "1/0", "0/0", "1/2/3"} {
+		if have, err := parseNumeric(in); err == nil {
+			t.Errorf("parseNumeric(%q)=%v, want error", in, have)
+		}
+	}
+}
+
+func TestAgeQuery(t *testing.T) {
+	for _, d := range []struct {
+		age  string
+		r    rune
+		want bool
+	}{
+		{"1.1", 'a', true},
+		{"1", 'a', true},
+		{">1.1", 'a', false},
+		{"<=1.1", 'a', true},
+		{"6.0", 0x1F600, false}, // 😀 is from 6.1.
+		{"6", 0x1F600, true},
+		{"6.1", 0x1F600, true},
+		{">6", 0x1F600, false},
+		{">=6.1", 0x1F600, true},
+		{"<6.1", 0x1F600, false},
+	} {
+		f, err := ageQuery(d.age)
+		if err != nil {
+			t.Errorf("ageQuery(%q) returned unexpected error=%v", d.age, err)
+			continue
+		}
+		if have := f(ucd.Lookup(d.r)); have != d.want {
+			t.Errorf("ageQuery(%q)(%U)=%v, want=%v", d.age, d.r, have, d.want)
+		}
+	}
+	for _, in := range []string{"", "x", "!6", "6.x"} {
+		if _, err := ageQuery(in); err == nil {
+			t.Errorf("ageQuery(%q) returned no error", in)
+		}
+	}
+}
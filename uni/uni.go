@@ -8,41 +8,197 @@ uni assists in identifying and enumerating Unicode characters.
   $ uni λ
   $ echo λ | uni
 
-  # Find characters by name.
+  # Find characters by name. Each word must appear in the name, in any
+  # order.
   $ uni tagalog
   $ uni camel
   $ uni math lamda
+  $ uni fraktur math
+
+  # List the characters in a block or script, optionally only those with
+  # names matching further words. Block and script names are matched
+  # ignoring case, spaces, underscores and hyphens.
+  $ uni -block 'Mathematical Alphanumeric Symbols'
+  $ uni -block mathematical_alphanumeric_symbols fraktur
+  $ uni -script ogham
+
+  # List the blocks, with their ranges.
+  $ uni -blocks
+
+  # List characters by property: general category (a prefix such as L
+  # matches all letters), bidi class, combining class, numeric value, East
+  # Asian width, binary properties such as Emoji or White_Space, and the
+  # version of Unicode they were added in. Properties can be combined with
+  # each other, with a block or script, and with words of names.
+  $ uni -cat Sc -age '>6'
+  $ uni -num 1/2
+  $ uni -prop emoji_presentation -age 14.0 face
+  $ uni -script hebrew -cat Mn -ccc 230
+
+  # Look up a character by its exact name, ignoring case, spaces,
+  # underscores and hyphens.
+  $ uni -x greek small letter lamda
+  $ uni -x Greek_Small_Letter_Lamda
+
+  # Show details: category, bidi class, block, script, age, width,
+  # decomposition, numeric value, case mappings, aliases, properties and so
+  # on.
+  $ uni -v ½
+  U+00BD [½] VULGAR FRACTION ONE HALF
+    category No; bidi ON; block Latin-1 Supplement; script Common; age 1.1; east asian width A; decomposition <fraction> U+0031 U+2044 U+0032; numeric 0.5; unicode 1 name FRACTION ONE HALF
+
+  # Break a string down into its code points, showing for each its byte
+  # offset, UTF-8 bytes, category and name. Invalid UTF-8, invisible
+  # characters, bidi controls and characters easily confused with others are
+  # flagged. Standard input of more than one character is broken down too.
+  $ uni -s 'Hello, world'
+  $ printf 'p\xd0\xb0ypal\xe2\x80\x8b.com' | uni
+  $ printf 'caf\xe9 cafe\xcc\x81' | uni
+
+  # Characters are shown by their corrected names, and controls by their
+  # aliases. Aliases such as abbreviations can be searched for.
+  $ uni 0a
+  $ uni zwj
 
 Credit to Larry Wall for the idea and original implementation.
 */
 package main
 
 import (
-	"bufio"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
-	"regexp"
 	"strconv"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/gaal/shstat/ucd"
 )
 
+var (
+	verbose = flag.Bool("v", false, "show character details")
+	exact   = flag.Bool("x", false, "look up characters by exact name")
+	block   = flag.String("block", "", "list the characters in the named block")
+	script  = flag.String("script", "", "list the characters in the named script")
+	blocks  = flag.Bool("blocks", false, "list the blocks")
+	str     = flag.Bool("s", false, "show each code point of the arguments, or of standard input, flagging surprising ones")
+
+	category = flag.String("cat", "", "list characters in the general categories, such as Sc or L, separated by commas")
+	bidi     = flag.String("bidi", "", "list characters with the bidi classes, such as R or AL, separated by commas")
+	ccc      = flag.String("ccc", "", "list characters with the canonical combining class")
+	numeric  = flag.String("num", "", "list characters with the numeric value, such as 5 or 1/2")
+	width    = flag.String("eaw", "", "list characters with the East Asian widths, such as W or F, separated by commas")
+	props    = flag.String("prop", "", "list characters with all the binary properties, such as Emoji, separated by commas")
+	age      = flag.String("age", "", "list characters added in the Unicode version, such as 6.1, 6 or '>6'")
+)
+
 func lookup(r rune) {
 	if rec := ucd.Lookup(r); rec != nil {
-		fmt.Printf("U+%04X [%c] %s\n", r, r, rec.Name())
+		show(rec)
+	}
+}
+
+func show(rec ucd.Record) {
+	r := rec.Rune()
+	fmt.Printf("U+%04X %s %s\n", r, glyph(r, rec), rec.DisplayName())
+	if *verbose {
+		fmt.Printf("  %s\n", details(rec))
+	}
+}
+
+// details describes the properties of a character, omitting defaults.
+func details(rec ucd.Record) string {
+	r := rec.Rune()
+	ds := []string{"category " + rec.Category(), "bidi " + rec.BidiClass(), "block " + rec.Block(), "script " + rec.Script(), "age " + rec.Age()}
+	if w := rec.EastAsianWidth(); w != "N" {
+		ds = append(ds, "east asian width "+w)
+	}
+	if rec.Mirrored() {
+		ds = append(ds, "mirrored")
+	}
+	if ccc := rec.CombiningClass(); ccc != 0 {
+		ds = append(ds, fmt.Sprintf("combining class %d", ccc))
+	}
+	if typ, m := rec.Decomposition(); m != nil {
+		d := "decomposition"
+		if typ != "" {
+			d += " <" + typ + ">"
+		}
+		for _, v := range m {
+			d += fmt.Sprintf(" U+%04X", v)
+		}
+		ds = append(ds, d)
+	}
+	if n, ok := rec.Decimal(); ok {
+		ds = append(ds, fmt.Sprintf("decimal %d", n))
+	} else if n, ok := rec.Digit(); ok {
+		ds = append(ds, fmt.Sprintf("digit %d", n))
+	} else if n, ok := rec.Numeric(); ok {
+		ds = append(ds, fmt.Sprintf("numeric %v", n))
+	}
+	for _, c := range []struct {
+		name string
+		to   rune
+	}{{"upper", rec.Upper()}, {"lower", rec.Lower()}, {"title", rec.Title()}} {
+		if c.to != r && (c.name != "title" || c.to != rec.Upper()) {
+			ds = append(ds, fmt.Sprintf("%s U+%04X [%c]", c.name, c.to, c.to))
+		}
+	}
+	if u1 := rec.Unicode1Name(); len(u1) > 0 {
+		ds = append(ds, "unicode 1 name "+string(u1))
+	}
+	if name := rec.Name(); string(name) != string(rec.DisplayName()) {
+		ds = append(ds, "name "+string(name))
+	}
+	for _, a := range rec.Aliases() {
+		ds = append(ds, a.Type+" alias "+a.Name)
+	}
+	if ps := rec.Properties(); ps != nil {
+		ds = append(ds, "properties "+strings.Join(ps, " "))
 	}
+	return strings.Join(ds, "; ")
 }
 
-func scan(re *regexp.Regexp) {
-	s := &ucd.Scanner{}
-	for ; !s.Done(); s.Next() {
-		rec := s.Record()
-		r := rec.Rune()
-		nam := rec.Name()
-		if re.Match(nam) {
-			fmt.Printf("U+%04X [%c] %s\n", r, r, nam)
+// list shows the characters in the ranges, or in all of Unicode if there
+// are none, that match the query and have names matching the terms, if any.
+func list(rs []ucd.Range, terms []string, q query) {
+	each := func(rec ucd.Record) {
+		if c := rec.Category(); (c == "Co" || c == "Cs") && *category == "" {
+			return // private use and surrogates have no names to find.
+		}
+		if q.match(rec) {
+			show(rec)
+		}
+	}
+	var found []rune
+	var match map[rune]bool
+	if len(terms) > 0 {
+		found = ucd.Search(terms...)
+		match = map[rune]bool{}
+		for _, r := range found {
+			match[r] = true
+		}
+	}
+	switch {
+	case rs != nil:
+		for _, rg := range rs {
+			for r := rg.First; r <= rg.Last; r++ {
+				if match != nil && !match[r] {
+					continue
+				}
+				if rec := ucd.Lookup(r); rec != nil {
+					each(rec)
+				}
+			}
+		}
+	case match != nil:
+		for _, r := range found {
+			each(ucd.Lookup(r))
+		}
+	default:
+		for s := (&ucd.Scanner{}); !s.Done(); s.Next() {
+			each(s.Record())
 		}
 	}
 }
@@ -50,6 +206,53 @@ func scan(re *regexp.Regexp) {
 func main() {
 	flag.Parse()
 	nargs := len(flag.Args())
+	q, err := newQuery()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	switch {
+	case *blocks:
+		for _, b := range ucd.Blocks() {
+			fmt.Printf("U+%04X..U+%04X %s\n", b.First, b.Last, b.Name)
+		}
+		os.Exit(0)
+	case *block != "":
+		b, ok := ucd.LookupBlock(*block)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "no block named %q\n", *block)
+			os.Exit(1)
+		}
+		list([]ucd.Range{b}, flag.Args(), q)
+		os.Exit(0)
+	case *script != "":
+		rs := ucd.ScriptRanges(*script)
+		if rs == nil {
+			fmt.Fprintf(os.Stderr, "no script named %q\n", *script)
+			os.Exit(1)
+		}
+		list(rs, flag.Args(), q)
+		os.Exit(0)
+	case q != nil:
+		list(nil, flag.Args(), q)
+		os.Exit(0)
+	}
+
+	if *exact {
+		rec := ucd.LookupName(strings.Join(flag.Args(), " "))
+		if rec == nil {
+			fmt.Fprintf(os.Stderr, "no character named %q\n", strings.Join(flag.Args(), " "))
+			os.Exit(1)
+		}
+		show(rec)
+		os.Exit(0)
+	}
+
+	if *str && nargs > 0 {
+		analyze(strings.Join(flag.Args(), " "))
+		os.Exit(0)
+	}
 
 	if nargs == 1 {
 		if r, err := strconv.ParseInt(flag.Arg(0), 16, 32); err == nil {
@@ -59,14 +262,19 @@ func main() {
 	}
 	switch nargs {
 	case 0:
-		// Read one character from stdin.
-		rd := bufio.NewReader(os.Stdin)
-		r, _, err := rd.ReadRune()
+		// Look up a character from stdin, or break down a longer string, or
+		// any string with -s.
+		b, err := ioutil.ReadAll(os.Stdin)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
 		}
-		lookup(r)
+		s := strings.TrimSuffix(string(b), "\n")
+		if r, size := utf8.DecodeRuneInString(s); size == len(s) && size > 0 && !*str {
+			lookup(r)
+			os.Exit(0)
+		}
+		analyze(s)
 	case 1:
 		r, size := utf8.DecodeRuneInString(flag.Arg(0))
 		if len(flag.Arg(0)) == size {
@@ -75,14 +283,6 @@ func main() {
 		}
 		fallthrough
 	default:
-		exp := "(?i)"
-		for i, v := range flag.Args() {
-			if i > 0 {
-				exp += ".*"
-			}
-			exp += regexp.QuoteMeta(v)
-		}
-		re := regexp.MustCompile(exp)
-		scan(re)
+		list(nil, flag.Args(), nil)
 	}
 }